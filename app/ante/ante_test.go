@@ -24,6 +24,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/ethereum/go-ethereum/core/types"
 	ethparams "github.com/ethereum/go-ethereum/params"
@@ -1348,6 +1349,61 @@ func (suite AnteTestSuite) TestAnteHandlerWithParams() {
 	suite.evmParamsOption = nil
 }
 
+func (suite *AnteTestSuite) TestAnteHandlerWithBlockGasLimit() {
+	addr, privKey := tests.NewAddrKey()
+	to := tests.GenerateAddress()
+
+	testCases := []struct {
+		name         string
+		blockGasUsed uint64
+		expErr       error
+	}{
+		{"success - tx gas within the EVM block gas left", 100000, nil},
+		{"fail - tx gas exceeding the EVM block gas left", 100001, errortypes.ErrOutOfGas},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.evmParamsOption = func(params *evmtypes.Params) {
+				params.BlockGasLimit = 200000
+			}
+			suite.SetupTest() // reset
+
+			acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+			suite.Require().NoError(acc.SetSequence(1))
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+			signedTx := evmtypes.NewTx(
+				suite.app.EvmKeeper.ChainID(),
+				1,
+				&to,
+				big.NewInt(10),
+				100000,
+				nil,
+				big.NewInt(ethparams.InitialBaseFee+1),
+				big.NewInt(1),
+				nil,
+				&types.AccessList{},
+			)
+			signedTx.From = addr.Hex()
+			tx := suite.CreateTestTx(signedTx, privKey, 1, false)
+
+			suite.ctx = suite.ctx.WithIsCheckTx(false).WithConsensusParams(*app.DefaultConsensusParams)
+			_, err := suite.app.EvmKeeper.AddBlockGasUsedTransient(suite.ctx, tc.blockGasUsed)
+			suite.Require().NoError(err)
+			suite.app.EvmKeeper.SetBalance(suite.ctx, addr, big.NewInt((ethparams.InitialBaseFee+10)*100000))
+			_, err = suite.anteHandler(suite.ctx, tx, false)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Contains(err.Error(), "EVM block gas left")
+			}
+		})
+	}
+	suite.evmParamsOption = nil
+}
+
 func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 	params := authtypes.DefaultParams()
 	msg := []byte{1, 2, 3, 4}
@@ -1405,6 +1461,127 @@ func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 	}
 }
 
+func (suite *AnteTestSuite) TestAnteHandlerBundle() {
+	addr, privKey := tests.NewAddrKey()
+	payer, payerKey := tests.NewAddrKey()
+	to := tests.GenerateAddress()
+	initBalance := big.NewInt(10000000000)
+
+	setup := func() {
+		suite.enableFeemarket = false
+		suite.SetupTest() // reset
+
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		suite.app.EvmKeeper.SetBalance(suite.ctx, addr, initBalance)
+
+		acc = suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, payer.Bytes())
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		suite.app.EvmKeeper.SetBalance(suite.ctx, payer, initBalance)
+
+		suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(100))
+	}
+
+	newBundle := func(nonces ...uint64) *evmtypes.MsgEthereumTxBundle {
+		bundle := evmtypes.NewMsgEthereumTxBundle()
+		for _, nonce := range nonces {
+			msg := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), nonce, &to, big.NewInt(10), 100000, big.NewInt(150), nil, nil, nil, nil)
+			msg.From = addr.Hex()
+			suite.Require().NoError(msg.Sign(suite.ethSigner, tests.NewSigner(privKey)))
+			bundle.Txs = append(bundle.Txs, msg)
+		}
+		return bundle
+	}
+
+	buildTx := func(bundle *evmtypes.MsgEthereumTxBundle) sdk.Tx {
+		tx, err := bundle.BuildTx(suite.clientCtx.TxConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom)
+		suite.Require().NoError(err)
+		return tx
+	}
+
+	fees := big.NewInt(2 * 100000 * 150)
+
+	testCases := []struct {
+		name       string
+		txFn       func() sdk.Tx
+		checkTx    bool
+		expPass    bool
+		expBalance *big.Int
+		expPayer   *big.Int
+	}{
+		{
+			"success - fees paid by the senders",
+			func() sdk.Tx {
+				return buildTx(newBundle(0, 1))
+			},
+			false, true, new(big.Int).Sub(initBalance, fees), initBalance,
+		},
+		{
+			"success - fees paid by the fee payer",
+			func() sdk.Tx {
+				bundle := newBundle(0, 1)
+				bundle.FeePayer = payer.Hex()
+				suite.Require().NoError(bundle.SignFeePayer(suite.app.EvmKeeper.ChainID(), tests.NewSigner(payerKey)))
+				return buildTx(bundle)
+			},
+			false, true, initBalance, new(big.Int).Sub(initBalance, fees),
+		},
+		{
+			"success - CheckTx, the sender balance only covers the value",
+			func() sdk.Tx {
+				suite.app.EvmKeeper.SetBalance(suite.ctx, addr, big.NewInt(20))
+				bundle := newBundle(0, 1)
+				bundle.FeePayer = payer.Hex()
+				suite.Require().NoError(bundle.SignFeePayer(suite.app.EvmKeeper.ChainID(), tests.NewSigner(payerKey)))
+				return buildTx(bundle)
+			},
+			true, true, big.NewInt(20), new(big.Int).Sub(initBalance, fees),
+		},
+		{
+			"fail - fee payer signed by another account",
+			func() sdk.Tx {
+				bundle := newBundle(0, 1)
+				bundle.FeePayer = payer.Hex()
+				suite.Require().NoError(bundle.SignFeePayer(suite.app.EvmKeeper.ChainID(), tests.NewSigner(payerKey)))
+				bundle.FeePayer = to.Hex()
+				return buildTx(bundle)
+			},
+			false, false, nil, nil,
+		},
+		{
+			"fail - invalid nonce of a bundled transaction",
+			func() sdk.Tx {
+				return buildTx(newBundle(0, 2))
+			},
+			false, false, nil, nil,
+		},
+		{
+			"fail - bundle with another message",
+			func() sdk.Tx {
+				txBuilder := suite.CreateTestTxBuilder(newBundle(0).Txs[0], privKey, 0, false)
+				suite.Require().NoError(txBuilder.SetMsgs(newBundle(1), newBundle(2)))
+				return txBuilder.GetTx()
+			},
+			false, false, nil, nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			setup()
+
+			_, err := suite.anteHandler(suite.ctx.WithIsCheckTx(tc.checkTx), tc.txFn(), false)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expBalance, suite.app.EvmKeeper.GetBalance(suite.ctx, addr))
+			suite.Require().Equal(tc.expPayer, suite.app.EvmKeeper.GetBalance(suite.ctx, payer))
+		})
+	}
+}
+
 func generatePubKeysAndSignatures(n int, msg []byte, _ bool) (pubkeys []cryptotypes.PubKey, signatures [][]byte, err error) {
 	pubkeys = make([]cryptotypes.PubKey, n)
 	signatures = make([][]byte, n)
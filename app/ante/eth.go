@@ -65,7 +65,10 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 		return next(ctx, tx, simulate)
 	}
 
-	for i, msg := range tx.GetMsgs() {
+	evmParams := avd.evmKeeper.GetParams(ctx)
+	_, hasFeePayer := evmtypes.GetBundleFeePayer(tx.GetMsgs())
+
+	for i, msg := range evmtypes.UnwrapBundles(tx.GetMsgs()) {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
 		if !ok {
 			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
@@ -95,8 +98,14 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 				"the sender is not EOA: address %s, codeHash <%s>", fromAddr, acct.CodeHash)
 		}
 
-		if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(acct.Balance.ToBig()), txData); err != nil {
-			return ctx, errorsmod.Wrap(err, "failed to check sender balance")
+		balance := sdkmath.NewIntFromBigInt(acct.Balance.ToBig())
+		if err := keeper.CheckSenderBalance(balance, txData); err != nil {
+			// the fees can be paid in an alternative denom or by the fee payer of a bundle,
+			// checked when deducted, but the evm denom balance must still cover the value
+			value := txData.GetValue()
+			if (len(evmParams.FeeDenoms) == 0 && !hasFeePayer) || (value != nil && balance.BigInt().Cmp(value) < 0) {
+				return ctx, errorsmod.Wrap(err, "failed to check sender balance")
+			}
 		}
 	}
 	return next(ctx, tx, simulate)
@@ -157,14 +166,15 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	blockHeight := big.NewInt(ctx.BlockHeight())
 	homestead := ethCfg.IsHomestead(blockHeight)
 	istanbul := ethCfg.IsIstanbul(blockHeight)
-	shanghai := ethCfg.IsShanghai(blockHeight, 1)
+	shanghai := ethCfg.IsShanghai(blockHeight, ethermint.BlockTime(ctx))
 	var events sdk.Events
 
 	// Use the lowest priority of all the messages as the final one.
 	minPriority := int64(math.MaxInt64)
 	baseFee := egcd.evmKeeper.GetBaseFee(ctx, ethCfg)
+	feePayer, hasFeePayer := evmtypes.GetBundleFeePayer(tx.GetMsgs())
 
-	for _, msg := range tx.GetMsgs() {
+	for _, msg := range evmtypes.UnwrapBundles(tx.GetMsgs()) {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
 		if !ok {
 			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
@@ -193,11 +203,24 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			return ctx, errorsmod.Wrapf(err, "failed to verify the fees")
 		}
 
-		err = egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.HexToAddress(msgEthTx.From))
+		// the fee payer of a bundle pays the fees, the value is still transferred by the sender
+		payer, value := common.HexToAddress(msgEthTx.From), txData.GetValue()
+		if hasFeePayer {
+			payer, value = feePayer, big.NewInt(0)
+		}
+
+		fees, err = egcd.evmKeeper.DeductTxCostsFromUserBalance(
+			ctx, fees, payer, value, common.HexToHash(msgEthTx.Hash),
+		)
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "failed to deduct transaction costs from user balance")
 		}
 
+		if hasFeePayer {
+			// record the fee payer to refund the leftover gas to it
+			egcd.evmKeeper.SetFeePayerTransient(ctx, common.HexToHash(msgEthTx.Hash), feePayer)
+		}
+
 		events = append(events,
 			sdk.NewEvent(
 				sdk.EventTypeTx,
@@ -214,9 +237,10 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 
 	ctx.EventManager().EmitEvents(events)
 
-	blockGasLimit := ethermint.BlockGasLimit(ctx)
+	blockGasLimit := evmParams.EVMBlockGasLimit(ethermint.BlockGasLimit(ctx))
 
-	// return error if the tx gas is greater than the block limit (max gas)
+	// return error if the tx gas is greater than the block limit (max gas) or the EVM
+	// block gas limit
 
 	// NOTE: it's important here to use the gas wanted instead of the gas consumed
 	// from the tx gas pool. The later only has the value so far since the
@@ -230,6 +254,21 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		)
 	}
 
+	// return error if the tx gas is greater than the EVM block gas left, the EVM gas
+	// is only accounted when the block gas limit param is set
+	if evmParams.BlockGasLimit > 0 {
+		blockGasUsed := egcd.evmKeeper.GetBlockGasUsedTransient(ctx)
+		if blockGasUsed > blockGasLimit || gasWanted > blockGasLimit-blockGasUsed {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrOutOfGas,
+				"tx gas (%d) exceeds the EVM block gas left (%d used of %d)",
+				gasWanted,
+				blockGasUsed,
+				blockGasLimit,
+			)
+		}
+	}
+
 	// Set tx GasMeter with a limit of GasWanted (i.e gas limit from the Ethereum tx).
 	// The gas consumed will be then reset to the gas used by the state transition
 	// in the EVM.
@@ -260,9 +299,9 @@ func NewCanTransferDecorator(evmKeeper EVMKeeper) CanTransferDecorator {
 func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	params := ctd.evmKeeper.GetParams(ctx)
 	ethCfg := params.ChainConfig.EthereumConfig(ctd.evmKeeper.ChainID())
-	signer := ethermint.MakeSigner(ethCfg, big.NewInt(ctx.BlockHeight()))
+	signer := ethermint.MakeSigner(ethCfg, big.NewInt(ctx.BlockHeight()), ethermint.BlockTime(ctx))
 
-	for _, msg := range tx.GetMsgs() {
+	for _, msg := range evmtypes.UnwrapBundles(tx.GetMsgs()) {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
 		if !ok {
 			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
@@ -278,6 +317,13 @@ func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 			)
 		}
 
+		if params.IsBlockedAddress(coreMsg.From) {
+			return ctx, errorsmod.Wrapf(evmtypes.ErrBlockedAddress, "sender %s", coreMsg.From.Hex())
+		}
+		if coreMsg.To != nil && params.IsBlockedAddress(*coreMsg.To) {
+			return ctx, errorsmod.Wrapf(evmtypes.ErrBlockedAddress, "recipient %s", coreMsg.To.Hex())
+		}
+
 		if evmtypes.IsLondon(ethCfg, ctx.BlockHeight()) {
 			if baseFee == nil {
 				return ctx, errorsmod.Wrap(
@@ -341,7 +387,7 @@ func NewEthIncrementSenderSequenceDecorator(ak evmtypes.AccountKeeper) EthIncrem
 // contract creation, the nonce will be incremented during the transaction execution and not within
 // this AnteHandler decorator.
 func (issd EthIncrementSenderSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	for _, msg := range tx.GetMsgs() {
+	for _, msg := range evmtypes.UnwrapBundles(tx.GetMsgs()) {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
 		if !ok {
 			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
@@ -4,6 +4,7 @@ import (
 	"math"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/holiman/uint256"
 
@@ -66,6 +67,26 @@ func (suite AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 			true,
 			false,
 		},
+		{
+			"fees payable in an alternative denom, not enough balance to cover the value",
+			tx,
+			func() {
+				params := suite.app.EvmKeeper.GetParams(suite.ctx)
+				params.FeeDenoms = []evmtypes.FeeDenom{{Denom: "uatom", Rate: sdkmath.LegacyOneDec()}}
+				suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+			},
+			true,
+			false,
+		},
+		{
+			"fees payable in an alternative denom, enough balance to cover the value",
+			tx,
+			func() {
+				vmdb.AddBalance(addr, uint256.NewInt(10))
+			},
+			true,
+			true,
+		},
 		{
 			"success new account",
 			tx,
@@ -43,10 +43,12 @@ type EVMKeeper interface {
 	DynamicFeeEVMKeeper
 
 	NewEVM(ctx sdk.Context, msg *core.Message, cfg *statedb.EVMConfig, tracer vm.EVMLogger, stateDB vm.StateDB) *vm.EVM
-	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
+	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address, value *big.Int, txHash common.Hash) (sdk.Coins, error)
+	SetFeePayerTransient(ctx sdk.Context, txHash common.Hash, payer common.Address)
 	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
 	ResetTransientGasUsed(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
+	GetBlockGasUsedTransient(ctx sdk.Context) uint64
 	GetParams(ctx sdk.Context) evmtypes.Params
 }
 
@@ -36,6 +36,12 @@ func (rmd RejectMessagesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 				"MsgEthereumTx needs to be contained within a tx with 'ExtensionOptionsEthereumTx' option",
 			)
 		}
+		if _, ok := msg.(*evmtypes.MsgEthereumTxBundle); ok {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrInvalidType,
+				"MsgEthereumTxBundle needs to be contained within a tx with 'ExtensionOptionsEthereumTx' option",
+			)
+		}
 	}
 	return next(ctx, tx, simulate)
 }
@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/zeta-chain/ethermint/app/ante"
 	"github.com/zeta-chain/ethermint/tests"
@@ -29,25 +30,30 @@ func (suite AnteTestSuite) TestEthSigVerificationDecorator() {
 		allowUnprotectedTxs bool
 		reCheckTx           bool
 		expPass             bool
+		createAllowlist     []string
 	}{
-		{"ReCheckTx", &invalidTx{}, false, true, false},
-		{"invalid transaction type", &invalidTx{}, false, false, false},
+		{"ReCheckTx", &invalidTx{}, false, true, false, nil},
+		{"invalid transaction type", &invalidTx{}, false, false, false, nil},
 		{
 			"invalid sender",
 			evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), 1, &addr, big.NewInt(10), 1000, big.NewInt(1), nil, nil, nil, nil),
 			true,
 			false,
 			false,
+			nil,
 		},
-		{"successful signature verification", signedTx, false, false, true},
-		{"invalid, reject unprotected txs", unprotectedTx, false, false, false},
-		{"successful, allow unprotected txs", unprotectedTx, true, false, true},
+		{"successful signature verification", signedTx, false, false, true, nil},
+		{"invalid, reject unprotected txs", unprotectedTx, false, false, false, nil},
+		{"successful, allow unprotected txs", unprotectedTx, true, false, true, nil},
+		{"successful, sender in the create allowlist", signedTx, false, false, true, []string{addr.Hex()}},
+		{"invalid, sender not in the create allowlist", signedTx, false, false, false, []string{common.Address{}.Hex()}},
 	}
 
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			suite.evmParamsOption = func(params *evmtypes.Params) {
 				params.AllowUnprotectedTxs = tc.allowUnprotectedTxs
+				params.CreateAllowlist = tc.createAllowlist
 			}
 			suite.SetupTest()
 			dec := ante.NewEthSigVerificationDecorator(suite.app.EvmKeeper)
@@ -48,9 +48,9 @@ func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 	chainCfg := evmParams.GetChainConfig()
 	ethCfg := chainCfg.EthereumConfig(chainID)
 	blockNum := big.NewInt(ctx.BlockHeight())
-	signer := ethermint.MakeSigner(ethCfg, blockNum)
+	signer := ethermint.MakeSigner(ethCfg, blockNum, ethermint.BlockTime(ctx))
 
-	for _, msg := range tx.GetMsgs() {
+	for _, msg := range evmtypes.UnwrapBundles(tx.GetMsgs()) {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
 		if !ok {
 			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
@@ -80,6 +80,18 @@ func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 				msgEthTx.From, sender.Hex(),
 			)
 		}
+
+		// the create allowlist is checked against the verified sender
+		if ethTx.To() == nil && !evmParams.IsCreateAllowed(sender) {
+			return ctx, errorsmod.Wrapf(evmtypes.ErrCreateNotAllowed, "address %s", sender.Hex())
+		}
+	}
+
+	// the fee payer of a bundle authorizes the payment of the fees of all its transactions
+	if bundle, ok := evmtypes.GetBundle(tx.GetMsgs()); ok {
+		if err := bundle.VerifyFeePayer(chainID); err != nil {
+			return ctx, err
+		}
 	}
 
 	return next(ctx, tx, simulate)
@@ -72,7 +72,6 @@ import (
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
-	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
@@ -135,8 +134,12 @@ import (
 	"github.com/zeta-chain/ethermint/ethereum/eip712"
 	srvflags "github.com/zeta-chain/ethermint/server/flags"
 	ethermint "github.com/zeta-chain/ethermint/types"
+	"github.com/zeta-chain/ethermint/x/erc20"
+	erc20keeper "github.com/zeta-chain/ethermint/x/erc20/keeper"
+	erc20types "github.com/zeta-chain/ethermint/x/erc20/types"
 	"github.com/zeta-chain/ethermint/x/evm"
 	evmkeeper "github.com/zeta-chain/ethermint/x/evm/keeper"
+	"github.com/zeta-chain/ethermint/x/evm/precompiles"
 	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
 	"github.com/zeta-chain/ethermint/x/feemarket"
 	feemarketkeeper "github.com/zeta-chain/ethermint/x/feemarket/keeper"
@@ -190,8 +193,9 @@ var (
 		vesting.AppModuleBasic{},
 		consensus.AppModuleBasic{},
 		// Ethermint modules
-		evm.AppModuleBasic{},
+		evm.NewAppModuleBasic(precompiles.StakingContractAddress),
 		feemarket.AppModuleBasic{},
+		erc20.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -204,10 +208,15 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner}, // used for the conversion of the token pairs
+		evmtypes.ContractFundsName:     nil,                                  // pays the value of the governance contract calls
 	}
 
 	// module accounts that are allowed to receive tokens
-	allowedReceivingModAcc = map[string]bool{}
+	allowedReceivingModAcc = map[string]bool{
+		// funded with community pool spend proposals
+		evmtypes.ContractFundsName: true,
+	}
 )
 
 var (
@@ -262,6 +271,7 @@ type EthermintApp struct {
 	// Ethermint keepers
 	EvmKeeper       *evmkeeper.Keeper
 	FeeMarketKeeper feemarketkeeper.Keeper
+	Erc20Keeper     erc20keeper.Keeper
 
 	// the module manager
 	ModuleManager      *module.Manager
@@ -275,8 +285,8 @@ type EthermintApp struct {
 }
 
 // SimulationManager implements runtime.AppI
-func (*EthermintApp) SimulationManager() *module.SimulationManager {
-	panic("unimplemented")
+func (app *EthermintApp) SimulationManager() *module.SimulationManager {
+	return app.sm
 }
 
 // NewEthermintApp returns a reference to a new initialized Ethermint application.
@@ -331,7 +341,7 @@ func NewEthermintApp(
 		// ibc keys
 		ibcexported.StoreKey, ibctransfertypes.StoreKey,
 		// ethermint keys
-		evmtypes.StoreKey, feemarkettypes.StoreKey,
+		evmtypes.StoreKey, feemarkettypes.StoreKey, erc20types.StoreKey,
 	)
 
 	// Add the EVM transient store key
@@ -524,9 +534,38 @@ func NewEthermintApp(
 		keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey], authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper,
 		tracer,
-		nil,
+		[]evmkeeper.CustomContractFn{
+			precompiles.NewStakingContractFn(app.StakingKeeper, app.DistrKeeper),
+		},
 		allKeys,
 	)
+	app.EvmKeeper.SetParamsContractsFns(precompiles.NewBankContractsFn(app.BankKeeper))
+
+	if evmtypes.IsNativeTracer(tracer) {
+		traceDir := cast.ToString(appOpts.Get(srvflags.EVMTracerOutputDir))
+		if traceDir == "" {
+			traceDir = filepath.Join(homePath, "data", "traces")
+		}
+		traceSink, err := evmkeeper.NewFileTraceSink(
+			traceDir,
+			cast.ToUint64(appOpts.Get(srvflags.EVMTracerRetainBlocks)),
+			cast.ToUint64(appOpts.Get(srvflags.EVMTracerMaxSizeMB))*1024*1024,
+		)
+		if err != nil {
+			panic(err)
+		}
+		app.EvmKeeper.SetTraceSink(traceSink)
+		if tracerConfig := cast.ToString(appOpts.Get(srvflags.EVMTracerConfig)); tracerConfig != "" {
+			app.EvmKeeper.SetTracerConfig(json.RawMessage(tracerConfig))
+		}
+	}
+
+	app.Erc20Keeper = erc20keeper.NewKeeper(
+		appCodec,
+		keys[erc20types.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.EvmKeeper,
+	)
 
 	// register the proposal types
 	govRouter := govv1beta1.NewRouter()
@@ -560,7 +599,8 @@ func NewEthermintApp(
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper, authAddr,
 	)
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
-	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
+	// the erc20 middleware registers the token pairs of the received IBC vouchers
+	transferIBCModule := erc20.NewIBCMiddleware(app.Erc20Keeper, transfer.NewIBCModule(app.TransferKeeper))
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
@@ -592,7 +632,7 @@ func NewEthermintApp(
 			app.AccountKeeper, app.StakingKeeper, app,
 			txConfig,
 		),
-		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts, app.GetSubspace(authtypes.ModuleName)),
+		auth.NewAppModule(appCodec, app.AccountKeeper, RandomGenesisAccounts, app.GetSubspace(authtypes.ModuleName)),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper, false),
@@ -624,6 +664,7 @@ func NewEthermintApp(
 		// Ethermint app modules
 		feemarket.NewAppModule(app.FeeMarketKeeper, feeMarketSs),
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper, evmSs),
+		erc20.NewAppModule(app.Erc20Keeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager which is in charge of setting up basic,
@@ -639,6 +680,7 @@ func NewEthermintApp(
 					paramsclient.ProposalHandler,
 				},
 			),
+			evmtypes.ModuleName: evm.NewAppModuleBasic(precompiles.StakingContractAddress),
 		},
 	)
 	app.BasicModuleManager.RegisterLegacyAminoCodec(cdc)
@@ -677,6 +719,7 @@ func NewEthermintApp(
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
 		consensusparamtypes.ModuleName,
+		erc20types.ModuleName,
 	)
 
 	// NOTE: fee market module must go last in order to retrieve the block gas used.
@@ -703,6 +746,7 @@ func NewEthermintApp(
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
 		consensusparamtypes.ModuleName,
+		erc20types.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -730,6 +774,8 @@ func NewEthermintApp(
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
+		// erc20 module deploys the contracts of the token pairs after the evm module
+		erc20types.ModuleName,
 		authz.ModuleName,
 		feegrant.ModuleName,
 		paramstypes.ModuleName,
@@ -763,7 +809,7 @@ func NewEthermintApp(
 	// NOTE: this is not required apps that don't use the simulator for fuzz testing
 	// transactions
 	overrideModules := map[string]module.AppModuleSimulation{
-		authtypes.ModuleName: auth.NewAppModule(app.appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts, app.GetSubspace(authtypes.ModuleName)),
+		authtypes.ModuleName: auth.NewAppModule(app.appCodec, app.AccountKeeper, RandomGenesisAccounts, app.GetSubspace(authtypes.ModuleName)),
 	}
 	app.sm = module.NewSimulationManagerFromAppModules(app.ModuleManager.Modules, overrideModules)
 
@@ -843,6 +889,7 @@ func (app *EthermintApp) setAnteHandler(txConfig client.TxConfig, maxGasWanted u
 		TxFeeChecker:           ante.NewDynamicFeeChecker(app.EvmKeeper),
 		DisabledAuthzMsgs: []string{
 			sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
+			sdk.MsgTypeURL(&evmtypes.MsgEthereumTxBundle{}),
 			sdk.MsgTypeURL(&vestingtypes.MsgCreateVestingAccount{}),
 		},
 	})
@@ -1000,6 +1047,11 @@ func (app *EthermintApp) GetMemKey(storeKey string) *storetypes.MemoryStoreKey {
 	return app.memKeys[storeKey]
 }
 
+// GetEVMKeeper returns the EVM keeper, used by the replay-evm command.
+func (app *EthermintApp) GetEVMKeeper() *evmkeeper.Keeper {
+	return app.EvmKeeper
+}
+
 // GetSubspace returns a param subspace for a given module name.
 //
 // NOTE: This is solely to be used for testing purposes.
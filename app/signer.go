@@ -26,7 +26,7 @@ func (s EthSignerExtractionAdapter) GetSigners(tx sdk.Tx) ([]mempool.SignerData,
 	if txWithExtensions, ok := tx.(authante.HasExtensionOptionsTx); ok {
 		opts := txWithExtensions.GetExtensionOptions()
 		if len(opts) > 0 && opts[0].GetTypeUrl() == "/ethermint.evm.v1.ExtensionOptionsEthereumTx" {
-			for _, msg := range tx.GetMsgs() {
+			for _, msg := range evmtypes.UnwrapBundles(tx.GetMsgs()) {
 				if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
 					txData, err := evmtypes.UnpackTxData(ethMsg.Data)
 					if err != nil {
@@ -17,6 +17,7 @@ package app
 
 import (
 	"encoding/json"
+	"math/rand"
 	"time"
 
 	"cosmossdk.io/log"
@@ -29,15 +30,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/zeta-chain/ethermint/crypto/ethsecp256k1"
 	"github.com/zeta-chain/ethermint/encoding"
 	ethermint "github.com/zeta-chain/ethermint/types"
+	feemarkettypes "github.com/zeta-chain/ethermint/x/feemarket/types"
 )
 
 type GenesisState map[string]json.RawMessage
@@ -217,3 +223,56 @@ func MakeConfigForTest() ethermint.EncodingConfig {
 	ModuleBasics.RegisterInterfaces(config.InterfaceRegistry)
 	return config
 }
+
+// RandomAccounts creates random simulation accounts with an ethsecp256k1 private key,
+// so that they can sign both cosmos and Ethereum transactions.
+func RandomAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accs := make([]simtypes.Account, n)
+
+	for i := 0; i < n; i++ {
+		// don't need that much entropy for simulation
+		privkeySeed := make([]byte, 15)
+		_, _ = r.Read(privkeySeed)
+
+		accs[i].PrivKey = &ethsecp256k1.PrivKey{Key: secp256k1.GenPrivKeyFromSecret(privkeySeed).Bytes()}
+		accs[i].PubKey = accs[i].PrivKey.PubKey()
+		accs[i].Address = sdk.AccAddress(accs[i].PubKey.Address())
+		accs[i].ConsKey = ed25519.GenPrivKeyFromSecret(privkeySeed)
+	}
+
+	return accs
+}
+
+// RandomGenesisAccounts returns the simulation accounts as Ethereum accounts. Vesting
+// accounts are not generated as their locked coins can't be spent by the EVM.
+func RandomGenesisAccounts(simState *module.SimulationState) authtypes.GenesisAccounts {
+	genesisAccs := make(authtypes.GenesisAccounts, len(simState.Accounts))
+	for i, acc := range simState.Accounts {
+		ethAcc := ethermint.ProtoAccount().(*ethermint.EthAccount)
+		ethAcc.BaseAccount = authtypes.NewBaseAccountWithAddress(acc.Address)
+		genesisAccs[i] = ethAcc
+	}
+
+	return genesisAccs
+}
+
+// StateFn returns the initial application state of the simulations, using a genesis
+// file or the simulation parameters. The fee market base fee starts at zero as the
+// operations of the cosmos modules pay random fees that are not priced against it.
+func StateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simtypes.AppStateFn {
+	return simtestutil.AppStateFnWithExtendedCb(
+		cdc, simManager, NewDefaultGenesisState(),
+		func(rawState map[string]json.RawMessage) {
+			feemarketStateBz, ok := rawState[feemarkettypes.ModuleName]
+			if !ok {
+				panic("fee market genesis state is missing")
+			}
+
+			feemarketState := new(feemarkettypes.GenesisState)
+			cdc.MustUnmarshalJSON(feemarketStateBz, feemarketState)
+			feemarketState.Params.BaseFee = sdkmath.ZeroInt()
+
+			rawState[feemarkettypes.ModuleName] = cdc.MustMarshalJSON(feemarketState)
+		},
+	)
+}
@@ -239,6 +239,9 @@ type appCreator struct {
 	encCfg ethermint.EncodingConfig
 }
 
+// the app can be replayed by the replay-evm command
+var _ server.ReplayEVMApp = (*app.EthermintApp)(nil)
+
 // newApp is an appCreator
 func (a appCreator) newApp(logger cmtlog.Logger, db dbm.DB, traceStore io.Writer, appOpts servertypes.AppOptions) servertypes.Application {
 	baseappOptions := sdkserver.DefaultBaseappOptions(appOpts)
@@ -43,6 +43,26 @@ func customGetSignerFn(path string) func(msg proto.Message) ([][]byte, error) {
 	}
 }
 
+// bundleGetSignerFn returns the senders of the transactions of a bundle, followed by its
+// fee payer if any.
+func bundleGetSignerFn(msg proto.Message) ([][]byte, error) {
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	txs := m.Get(fields.ByName("txs")).List()
+
+	signers := make([][]byte, 0, txs.Len()+1)
+	for i := 0; i < txs.Len(); i++ {
+		tx := txs.Get(i).Message()
+		from := common.HexToAddress(tx.Get(tx.Descriptor().Fields().ByName("from")).String())
+		signers = append(signers, sdk.AccAddress(from.Bytes()))
+	}
+
+	if feePayer := m.Get(fields.ByName("fee_payer")).String(); feePayer != "" {
+		signers = append(signers, sdk.AccAddress(common.HexToAddress(feePayer).Bytes()))
+	}
+	return signers, nil
+}
+
 // MakeConfig creates an EncodingConfig
 func MakeConfig() ethermint.EncodingConfig {
 	cdc := amino.NewLegacyAmino()
@@ -54,7 +74,8 @@ func MakeConfig() ethermint.EncodingConfig {
 			Bech32Prefix: sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
 		},
 		CustomGetSigners: map[protoreflect.FullName]signing.GetSignersFunc{
-			"ethermint.evm.v1.MsgEthereumTx": customGetSignerFn("from"),
+			"ethermint.evm.v1.MsgEthereumTx":       customGetSignerFn("from"),
+			"ethermint.evm.v1.MsgEthereumTxBundle": bundleGetSignerFn,
 		},
 	}
 	interfaceRegistry, err := types.NewInterfaceRegistryWithOptions(types.InterfaceRegistryOptions{
@@ -92,7 +92,7 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ExecTxRe
 		}
 
 		var cumulativeGasUsed uint64
-		for msgIndex, msg := range tx.GetMsgs() {
+		for msgIndex, msg := range evmtypes.UnwrapBundles(tx.GetMsgs()) {
 			ethMsg := msg.(*evmtypes.MsgEthereumTx)
 			txHash := common.HexToHash(ethMsg.Hash)
 
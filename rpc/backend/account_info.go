@@ -84,12 +84,24 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 
 	clientCtx := b.clientCtx.WithHeight(height)
 
+	// query the storage incarnation, the storage keys are scoped to it
+	var incarnation uint64
+	if len(storageKeys) > 0 {
+		incarnationBz, _, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.IncarnationKey(address))
+		if err != nil {
+			return nil, err
+		}
+		if len(incarnationBz) == 8 {
+			incarnation = sdk.BigEndianToUint64(incarnationBz)
+		}
+	}
+
 	// query storage proofs
 	storageProofs := make([]rpctypes.StorageResult, len(storageKeys))
 
 	for i, key := range storageKeys {
 		hexKey := common.HexToHash(key)
-		valueBz, proof, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, hexKey.Bytes()))
+		valueBz, proof, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, incarnation, hexKey.Bytes()))
 		if err != nil {
 			return nil, err
 		}
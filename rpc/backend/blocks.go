@@ -283,7 +283,7 @@ func (b *Backend) EthMsgsFromTendermintBlock(
 			continue
 		}
 
-		for _, msg := range tx.GetMsgs() {
+		for _, msg := range evmtypes.UnwrapBundles(tx.GetMsgs()) {
 			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
 			if !ok {
 				continue
@@ -452,6 +452,15 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 		b.logger.Error("failed to query consensus params", "error", err.Error())
 	}
 
+	// the EVM block gas limit param lowers the block gas limit of the EVM transactions
+	evmParams, err := b.queryClient.Params(ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		b.logger.Debug("failed to query evm params", "height", block.Height, "error", err.Error())
+	} else {
+		// #nosec G115 gasLimit always positive
+		gasLimit = int64(evmParams.Params.EVMBlockGasLimit(uint64(gasLimit)))
+	}
+
 	gasUsed := uint64(0)
 
 	for _, txsResult := range blockRes.TxsResults {
@@ -165,6 +165,7 @@ func (suite *BackendTestSuite) TestGetBlockByNumber() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, height)
 			},
 			false,
 			true,
@@ -187,6 +188,7 @@ func (suite *BackendTestSuite) TestGetBlockByNumber() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, height)
 			},
 			false,
 			true,
@@ -310,6 +312,7 @@ func (suite *BackendTestSuite) TestGetBlockByHash() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, height)
 			},
 			false,
 			true,
@@ -333,6 +336,7 @@ func (suite *BackendTestSuite) TestGetBlockByHash() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, height)
 			},
 			false,
 			true,
@@ -905,6 +909,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, height)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParams(client, height)
@@ -929,6 +934,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFeeError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, height)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParams(client, height)
@@ -953,6 +959,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccountError(queryClient)
+				RegisterParamsWithoutHeader(queryClient, height)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParams(client, height)
@@ -977,6 +984,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, height)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParamsError(client, height)
@@ -1007,6 +1015,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, height)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParams(client, height)
@@ -1031,6 +1040,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, height)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParams(client, height)
@@ -1055,6 +1065,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, height)
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParams(client, height)
@@ -1119,6 +1130,39 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 	}
 }
 
+func (suite *BackendTestSuite) TestRPCBlockEVMBlockGasLimit() {
+	emptyBlock := tmtypes.MakeBlock(1, []tmtypes.Tx{}, nil, nil)
+	resBlock := &tmrpctypes.ResultBlock{Block: emptyBlock}
+	blockRes := &tmrpctypes.ResultBlockResults{
+		Height:     1,
+		TxsResults: []*types.ExecTxResult{{Code: 0, GasUsed: 0}},
+	}
+
+	testCases := []struct {
+		name          string
+		blockGasLimit uint64
+		expGasLimit   hexutil.Uint64
+	}{
+		{"consensus max gas", 0, hexutil.Uint64(^uint32(0))},
+		{"evm block gas limit", 10_000_000, hexutil.Uint64(10_000_000)},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+			RegisterBaseFee(queryClient, sdkmath.NewInt(1))
+			RegisterValidatorAccount(queryClient, sdk.AccAddress(tests.GenerateAddress().Bytes()))
+			RegisterParamsWithBlockGasLimit(queryClient, 1, tc.blockGasLimit)
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			RegisterConsensusParams(client, 1)
+
+			block, err := suite.backend.RPCBlockFromTendermintBlock(resBlock, blockRes, false)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expGasLimit, block["gasLimit"])
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestEthMsgsFromTendermintBlock() {
 	msgEthereumTx, bz := suite.buildEthereumTx()
 
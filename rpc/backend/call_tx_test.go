@@ -424,7 +424,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum, nil, nil)
 
 			if tc.expPass {
 				suite.Require().Equal(tc.expEthTx, msgEthTx)
@@ -435,6 +435,41 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 }
 
+func (suite *BackendTestSuite) TestEstimateGasOverrides() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := tests.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{
+		To:      &toAddr,
+		ChainID: (*hexutil.Big)(suite.backend.chainID),
+	}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+
+	balance := (*hexutil.Big)(big.NewInt(100))
+	overrides := rpctypes.StateOverride{toAddr: {Balance: &balance}}
+	overridesBz, err := json.Marshal(overrides)
+	suite.Require().NoError(err)
+	number := (*hexutil.Big)(big.NewInt(10))
+	blockOverrides := rpctypes.BlockOverrides{Number: number}
+	blockOverridesBz, err := json.Marshal(blockOverrides)
+	suite.Require().NoError(err)
+
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterBlock(client, 1, bz)
+	queryClient.On("EstimateGas", rpctypes.ContextWithHeight(1), &evmtypes.EthCallRequest{
+		Args:           argsBz,
+		ChainId:        suite.backend.chainID.Int64(),
+		Overrides:      overridesBz,
+		BlockOverrides: blockOverridesBz,
+	}).Return(&evmtypes.EstimateGasResponse{Gas: 21000}, nil)
+
+	blockNr := rpctypes.BlockNumber(1)
+	gas, err := suite.backend.EstimateGas(callArgs, &blockNr, &overrides, &blockOverrides)
+	suite.Require().NoError(err)
+	suite.Require().Equal(hexutil.Uint64(21000), gas)
+}
+
 func (suite *BackendTestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
@@ -396,6 +396,7 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				RegisterBlockResults(client, 1)
 				RegisterBaseFeeError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
+				RegisterParamsWithoutHeader(queryClient, 1)
 				RegisterConsensusParams(client, 1)
 			},
 			1,
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -64,7 +65,9 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 		return common.Hash{}, err
 	}
 
-	signer := ethermint.MakeSigner(b.ChainConfig(), new(big.Int).SetUint64(uint64(bn)))
+	// the transaction is included in a later block, so the current time is used for the time based forks
+	// #nosec G115 timestamp always positive
+	signer := ethermint.MakeSigner(b.ChainConfig(), new(big.Int).SetUint64(uint64(bn)), uint64(time.Now().Unix()))
 
 	// Sign transaction
 	if err := msg.Sign(signer, b.clientCtx.Keyring); err != nil {
@@ -15,6 +15,8 @@ import (
 	"github.com/zeta-chain/ethermint/crypto/ethsecp256k1"
 	"github.com/zeta-chain/ethermint/indexer"
 	"github.com/zeta-chain/ethermint/rpc/backend/mocks"
+	rpctypes "github.com/zeta-chain/ethermint/rpc/types"
+	"github.com/zeta-chain/ethermint/tests"
 	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
 )
 
@@ -250,3 +252,71 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestTraceCall() {
+	toAddr := tests.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{To: &toAddr}
+	blockNum := rpctypes.BlockNumber(1)
+
+	testCases := []struct {
+		name           string
+		registerMock   func()
+		config         *rpctypes.TraceCallConfig
+		expTraceResult interface{}
+		expPass        bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - query client errors",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, nil)
+				RegisterTraceCallError(queryClient)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - with state overrides",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, nil)
+				RegisterTraceCall(queryClient)
+			},
+			&rpctypes.TraceCallConfig{
+				TraceConfig:    &evmtypes.TraceConfig{},
+				StateOverrides: &rpctypes.StateOverride{toAddr: {}},
+			},
+			map[string]interface{}{"test": "hello"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			traceResult, err := suite.backend.TraceCall(callArgs, rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}, tc.config)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expTraceResult, traceResult)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
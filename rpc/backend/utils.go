@@ -96,7 +96,7 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 	// add the uncommitted txs to the nonce counter
 	// only supports `MsgEthereumTx` style tx
 	for _, tx := range pendingTxs {
-		for _, msg := range (*tx).GetMsgs() {
+		for _, msg := range evmtypes.UnwrapBundles((*tx).GetMsgs()) {
 			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
 			if !ok {
 				// not ethereum tx
@@ -183,7 +183,7 @@ func (b *Backend) processBlock(
 		}
 		// #nosec G115 gas used always positive
 		txGasUsed := uint64(eachTendermintTxResult.GasUsed)
-		for _, msg := range tx.GetMsgs() {
+		for _, msg := range evmtypes.UnwrapBundles(tx.GetMsgs()) {
 			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
 			if !ok {
 				continue
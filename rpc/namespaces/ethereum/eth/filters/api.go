@@ -187,7 +187,7 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 
 				api.filtersMu.Lock()
 				if f, found := api.filters[pendingTxSub.ID()]; found {
-					for _, msg := range tx.GetMsgs() {
+					for _, msg := range evmtypes.UnwrapBundles(tx.GetMsgs()) {
 						ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
 						if ok {
 							f.hashes = append(f.hashes, ethTx.AsTransaction().Hash())
@@ -251,7 +251,7 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 					continue
 				}
 
-				for _, msg := range tx.GetMsgs() {
+				for _, msg := range evmtypes.UnwrapBundles(tx.GetMsgs()) {
 					ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
 					if ok {
 						_ = notifier.Notify(rpcSub.ID, ethTx.AsTransaction().Hash())
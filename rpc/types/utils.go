@@ -50,8 +50,9 @@ func RawTxToEthTx(clientCtx client.Context, txBz tmtypes.Tx) ([]*evmtypes.MsgEth
 		return nil, errorsmod.Wrap(errortypes.ErrJSONUnmarshal, err.Error())
 	}
 
-	ethTxs := make([]*evmtypes.MsgEthereumTx, len(tx.GetMsgs()))
-	for i, msg := range tx.GetMsgs() {
+	msgs := evmtypes.UnwrapBundles(tx.GetMsgs())
+	ethTxs := make([]*evmtypes.MsgEthereumTx, len(msgs))
+	for i, msg := range msgs {
 		ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
 		if !ok {
 			return nil, fmt.Errorf("invalid message type %T, expected %T", msg, &evmtypes.MsgEthereumTx{})
@@ -16,6 +16,7 @@
 package config
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"path"
@@ -28,6 +29,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
 )
 
 const (
@@ -46,6 +49,18 @@ const (
 	// DefaultEVMTracer is the default vm.Tracer type
 	DefaultEVMTracer = ""
 
+	// DefaultEVMTracerOutputDir is the default directory of the native tracer results,
+	// empty means the `data/traces` directory of the node home.
+	DefaultEVMTracerOutputDir = ""
+
+	// DefaultEVMTracerRetainBlocks is the default number of the most recent blocks whose
+	// native tracer results are kept.
+	DefaultEVMTracerRetainBlocks = 10000
+
+	// DefaultEVMTracerMaxSizeMB is the default maximum total size in MB of the native
+	// tracer results.
+	DefaultEVMTracerMaxSizeMB = 10240
+
 	// DefaultFixRevertGasRefundHeight is the default height at which to overwrite gas refund
 	DefaultFixRevertGasRefundHeight = 0
 
@@ -97,6 +112,17 @@ type EVMConfig struct {
 	// Tracer defines vm.Tracer type that the EVM will use if the node is run in
 	// trace mode. Default: 'json'.
 	Tracer string `mapstructure:"tracer"`
+	// TracerConfig defines the json config of the native tracer.
+	TracerConfig string `mapstructure:"tracer-config"`
+	// TracerOutputDir defines the directory the native tracer results are written
+	// to, one file per block.
+	TracerOutputDir string `mapstructure:"tracer-output-dir"`
+	// TracerRetainBlocks defines the number of the most recent blocks whose native
+	// tracer results are kept, the older files are pruned. Zero keeps all the blocks.
+	TracerRetainBlocks uint64 `mapstructure:"tracer-retain-blocks"`
+	// TracerMaxSizeMB defines the maximum total size in MB of the native tracer results,
+	// the files of the oldest blocks are pruned above it. Zero means no limit.
+	TracerMaxSizeMB uint64 `mapstructure:"tracer-max-size-mb"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
 }
@@ -199,15 +225,22 @@ func DefaultConfig() *Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:             DefaultEVMTracer,
+		TracerOutputDir:    DefaultEVMTracerOutputDir,
+		TracerRetainBlocks: DefaultEVMTracerRetainBlocks,
+		TracerMaxSizeMB:    DefaultEVMTracerMaxSizeMB,
+		MaxTxGasWanted:     DefaultMaxTxGasWanted,
 	}
 }
 
 // Validate returns an error if the tracer type is invalid.
 func (c EVMConfig) Validate() error {
-	if c.Tracer != "" && !strings.StringInSlice(c.Tracer, evmTracers) {
-		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
+	if c.Tracer != "" && !strings.StringInSlice(c.Tracer, evmTracers) && !evmtypes.IsNativeTracer(c.Tracer) {
+		return fmt.Errorf("invalid tracer type %s, available types: %v or a native tracer", c.Tracer, evmTracers)
+	}
+
+	if c.TracerConfig != "" && !json.Valid([]byte(c.TracerConfig)) {
+		return fmt.Errorf("invalid tracer config %s, expected a json object", c.TracerConfig)
 	}
 
 	return nil
@@ -333,8 +366,12 @@ func GetConfig(v *viper.Viper) (Config, error) {
 	return Config{
 		Config: cfg,
 		EVM: EVMConfig{
-			Tracer:         v.GetString("evm.tracer"),
-			MaxTxGasWanted: v.GetUint64("evm.max-tx-gas-wanted"),
+			Tracer:             v.GetString("evm.tracer"),
+			TracerConfig:       v.GetString("evm.tracer-config"),
+			TracerOutputDir:    v.GetString("evm.tracer-output-dir"),
+			TracerRetainBlocks: v.GetUint64("evm.tracer-retain-blocks"),
+			TracerMaxSizeMB:    v.GetUint64("evm.tracer-max-size-mb"),
+			MaxTxGasWanted:     v.GetUint64("evm.max-tx-gas-wanted"),
 		},
 		JSONRPC: JSONRPCConfig{
 			Enable:                   v.GetBool("json-rpc.enable"),
@@ -12,3 +12,26 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestEVMConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		cfg     EVMConfig
+		expPass bool
+	}{
+		{"default", *DefaultEVMConfig(), true},
+		{"struct logger", EVMConfig{Tracer: "struct"}, true},
+		{"native tracer", EVMConfig{Tracer: "callTracer", TracerConfig: `{"onlyTopCall":true}`}, true},
+		{"invalid tracer", EVMConfig{Tracer: "invalid"}, false},
+		{"invalid tracer config", EVMConfig{Tracer: "callTracer", TracerConfig: "{"}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.cfg.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -25,9 +25,25 @@ const DefaultConfigTemplate = `
 
 # Tracer defines the 'vm.Tracer' type that the EVM will use when the node is run in
 # debug mode. To enable tracing use the '--evm.tracer' flag when starting your node.
-# Valid types are: json|struct|access_list|markdown
+# Valid types are: json|struct|access_list|markdown or any go-ethereum native tracer,
+# e.g. callTracer|prestateTracer|4byteTracer.
 tracer = "{{ .EVM.Tracer }}"
 
+# TracerConfig defines the json config of the native tracer, e.g. '{"onlyTopCall":true}'.
+tracer-config = '{{ .EVM.TracerConfig }}'
+
+# TracerOutputDir defines the directory the native tracer results are written to,
+# one file per block. Defaults to the 'data/traces' directory of the node home.
+tracer-output-dir = "{{ .EVM.TracerOutputDir }}"
+
+# TracerRetainBlocks defines the number of the most recent blocks whose native tracer
+# results are kept, the files of the older blocks are pruned. 0 keeps all the blocks.
+tracer-retain-blocks = {{ .EVM.TracerRetainBlocks }}
+
+# TracerMaxSizeMB defines the maximum total size in MB of the native tracer results,
+# the files of the oldest blocks are pruned above it. 0 means no limit.
+tracer-max-size-mb = {{ .EVM.TracerMaxSizeMB }}
+
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
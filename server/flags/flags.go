@@ -74,8 +74,12 @@ const (
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer             = "evm.tracer"
+	EVMTracerConfig       = "evm.tracer-config"
+	EVMTracerOutputDir    = "evm.tracer-output-dir"
+	EVMTracerRetainBlocks = "evm.tracer-retain-blocks"
+	EVMTracerMaxSizeMB    = "evm.tracer-max-size-mb"
+	EVMMaxTxGasWanted     = "evm.max-tx-gas-wanted"
 )
 
 // TLS flags
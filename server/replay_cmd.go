@@ -0,0 +1,430 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	coreheader "cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtnode "github.com/cometbft/cometbft/config"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sm "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/zeta-chain/ethermint/rpc/backend"
+	rpctypes "github.com/zeta-chain/ethermint/rpc/types"
+	evmkeeper "github.com/zeta-chain/ethermint/x/evm/keeper"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+// ReplayEVMApp defines the application methods used by the replay-evm command to
+// re-execute the transactions of the committed blocks.
+type ReplayEVMApp interface {
+	servertypes.Application
+
+	CommitMultiStore() storetypes.CommitMultiStore
+	AnteHandler() sdk.AnteHandler
+	MsgServiceRouter() *baseapp.MsgServiceRouter
+	TxDecode(txBytes []byte) (sdk.Tx, error)
+	PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error)
+	BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error)
+	EndBlocker(ctx sdk.Context) (sdk.EndBlock, error)
+	GetEVMKeeper() *evmkeeper.Keeper
+}
+
+func NewReplayEVMCmd(opts StartOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-evm [start-height] [end-height]",
+		Short: "Re-execute the EVM transactions of committed blocks and compare the results",
+		Long: `Re-execute the transactions of the blocks from start-height to end-height (start-height if omitted)
+and compare the EVM results with the stored block results, reporting the first divergence.
+
+Each block is replayed on top of the app state of the previous height, loaded from the local db,
+so the pruning must keep it. The replayed state changes are discarded. The pre, begin and end
+blockers of all the modules, and the ante handler and the messages of all the transactions are
+executed, the EVM transactions with ApplyTransaction. The tx status, gas used and logs of every
+EVM transaction and the block bloom are compared.
+
+The node must be stopped, as the command opens the local dbs.
+		`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			start, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start height %s: %w", args[0], err)
+			}
+			end := start
+			if len(args) > 1 {
+				if end, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+					return fmt.Errorf("invalid end height %s: %w", args[1], err)
+				}
+			}
+			if start < 2 || end < start {
+				return fmt.Errorf("invalid block range [%d, %d]", start, end)
+			}
+
+			cfg := serverCtx.Config
+			db, err := opts.DBOpener(serverCtx.Viper, cfg.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app, ok := opts.AppCreator(serverCtx.Logger, db, nil, serverCtx.Viper).(ReplayEVMApp)
+			if !ok {
+				return fmt.Errorf("the application doesn't support the EVM replay")
+			}
+
+			// open local tendermint db, because the local rpc won't be available.
+			cmtdb, err := cmtnode.DefaultDBProvider(&cmtnode.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return err
+			}
+			blockStore := cmtstore.NewBlockStore(cmtdb)
+			defer blockStore.Close()
+
+			stateDB, err := cmtnode.DefaultDBProvider(&cmtnode.DBContext{ID: "state", Config: cfg})
+			if err != nil {
+				return err
+			}
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+			})
+			defer stateStore.Close()
+
+			if end > blockStore.Height() {
+				return fmt.Errorf("end height %d is above the latest block %d", end, blockStore.Height())
+			}
+
+			state, err := stateStore.Load()
+			if err != nil {
+				return err
+			}
+
+			for height := start; height <= end; height++ {
+				blk := blockStore.LoadBlock(height)
+				if blk == nil {
+					return fmt.Errorf("block not found %d", height)
+				}
+				resBlk, err := stateStore.LoadFinalizeBlockResponse(height)
+				if err != nil {
+					return err
+				}
+				consensusParams, err := stateStore.LoadConsensusParams(height)
+				if err != nil {
+					return err
+				}
+				lastCommit, err := lastCommitInfo(blk, stateStore, state.InitialHeight)
+				if err != nil {
+					return err
+				}
+
+				evmTxs, err := replayBlock(app, blk, resBlk, consensusParams.ToProto(), lastCommit, serverCtx.Logger)
+				if err != nil {
+					return err
+				}
+				cmd.Printf("%d: %d evm txs replayed\n", height, evmTxs)
+			}
+
+			return nil
+		},
+	}
+	return cmd
+}
+
+// lastCommitInfo returns the votes of the last commit of the block like comet passes them to
+// FinalizeBlock, with the validator set of the previous height.
+func lastCommitInfo(blk *cmttypes.Block, stateStore sm.Store, initialHeight int64) (abci.CommitInfo, error) {
+	if blk.Height == initialHeight {
+		return abci.CommitInfo{}, nil
+	}
+
+	lastValSet, err := stateStore.LoadValidators(blk.Height - 1)
+	if err != nil {
+		return abci.CommitInfo{}, err
+	}
+	return sm.BuildLastCommitInfo(blk, lastValSet, initialHeight), nil
+}
+
+// replayBlock re-executes the block on top of the app state of the previous height, running
+// the blockers of all the modules like the baseapp FinalizeBlock, and compares the EVM results
+// with the stored ones. It returns the number of EVM transactions replayed, or an error
+// describing the first divergence.
+func replayBlock(
+	app ReplayEVMApp,
+	blk *cmttypes.Block,
+	resBlk *abci.ResponseFinalizeBlock,
+	consensusParams cmtproto.ConsensusParams,
+	lastCommit abci.CommitInfo,
+	logger log.Logger,
+) (int, error) {
+	if len(resBlk.TxResults) != len(blk.Txs) {
+		return 0, fmt.Errorf("block %d has %d txs but %d tx results", blk.Height, len(blk.Txs), len(resBlk.TxResults))
+	}
+
+	ms, err := app.CommitMultiStore().CacheMultiStoreWithVersion(blk.Height - 1)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load the app state at height %d: %w", blk.Height-1, err)
+	}
+
+	req := &abci.RequestFinalizeBlock{
+		Txs:                blk.Txs.ToSliceOfBytes(),
+		DecidedLastCommit:  lastCommit,
+		Misbehavior:        blk.Evidence.Evidence.ToABCI(),
+		Hash:               blk.Hash(),
+		Height:             blk.Height,
+		Time:               blk.Time,
+		NextValidatorsHash: blk.NextValidatorsHash,
+		ProposerAddress:    blk.ProposerAddress,
+	}
+
+	ctx := sdk.NewContext(ms, *blk.Header.ToProto(), false, logger).
+		WithHeaderHash(req.Hash).
+		WithHeaderInfo(coreheader.Info{
+			ChainID: blk.ChainID,
+			Height:  blk.Height,
+			Time:    blk.Time,
+			Hash:    req.Hash,
+			AppHash: blk.AppHash,
+		}).
+		WithConsensusParams(consensusParams).
+		WithVoteInfos(lastCommit.Votes).
+		WithExecMode(sdk.ExecModeFinalize).
+		WithCometInfo(baseapp.NewBlockInfo(req.Misbehavior, req.NextValidatorsHash, req.ProposerAddress, lastCommit)).
+		WithBlockGasMeter(blockGasMeter(consensusParams))
+
+	if _, err := app.PreBlocker(ctx, req); err != nil {
+		return 0, fmt.Errorf("failed to run the pre blockers: %w", err)
+	}
+	if _, err := app.BeginBlocker(ctx); err != nil {
+		return 0, fmt.Errorf("failed to run the begin blockers: %w", err)
+	}
+	// the block gas meter is reset after the begin blockers, like the baseapp
+	ctx = ctx.WithBlockGasMeter(blockGasMeter(consensusParams))
+
+	evmTxs := 0
+	for i, txBz := range blk.Txs {
+		tx, err := app.TxDecode(txBz)
+		if err != nil {
+			// undecodable txs are rejected without state changes
+			continue
+		}
+
+		responses, replayErr := replayTx(app, ctx.WithTxBytes(txBz).WithEventManager(sdk.NewEventManager()), tx)
+		if err := compareTxResult(tx, resBlk.TxResults[i], responses, replayErr); err != nil {
+			return 0, fmt.Errorf("divergence at block %d tx %d (%X): %w", blk.Height, i, cmttypes.Tx(txBz).Hash(), err)
+		}
+		evmTxs += len(ethereumTxs(tx))
+	}
+
+	// the block bloom is emitted by the EVM end blocker
+	endBlock, err := app.EndBlocker(ctx.WithEventManager(sdk.NewEventManager()))
+	if err != nil {
+		return 0, fmt.Errorf("failed to run the end blockers: %w", err)
+	}
+	bloom, found := blockBloom(endBlock.Events)
+	storedBloom, storedFound := blockBloom(resBlk.Events)
+	if found != storedFound || bloom != storedBloom {
+		return 0, fmt.Errorf("divergence at block %d: block bloom %x, stored %x", blk.Height, bloom, storedBloom)
+	}
+
+	return evmTxs, nil
+}
+
+// replayTx executes the transaction like the baseapp in finalize mode: the ante handler
+// state changes are kept even if the messages fail, the messages ones only if they all
+// succeed. It returns the responses of the Ethereum transactions.
+func replayTx(app ReplayEVMApp, ctx sdk.Context, tx sdk.Tx) ([]*evmtypes.MsgEthereumTxResponse, error) {
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	defer func() {
+		ctx.BlockGasMeter().ConsumeGas(ctx.GasMeter().GasConsumedToLimit(), "block gas meter")
+	}()
+
+	if anteHandler := app.AnteHandler(); anteHandler != nil {
+		anteCtx, writeAnte := ctx.CacheContext()
+		newCtx, err := anteHandler(anteCtx, tx, false)
+		if !newCtx.IsZero() {
+			ctx = newCtx.WithMultiStore(ctx.MultiStore())
+		}
+		if err != nil {
+			return nil, err
+		}
+		writeAnte()
+	}
+
+	msgCtx, writeMsgs := ctx.CacheContext()
+	var responses []*evmtypes.MsgEthereumTxResponse
+	applyTransaction := func(msg *evmtypes.MsgEthereumTx) (*evmtypes.MsgEthereumTxResponse, error) {
+		res, err := app.GetEVMKeeper().ApplyTransaction(msgCtx, msg)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, res)
+		return res, nil
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		switch msg := msg.(type) {
+		case *evmtypes.MsgEthereumTx:
+			if _, err := applyTransaction(msg); err != nil {
+				return nil, err
+			}
+		case *evmtypes.MsgEthereumTxBundle:
+			for _, bundled := range msg.Txs {
+				res, err := applyTransaction(bundled)
+				if err != nil {
+					return nil, err
+				}
+				if res.Failed() {
+					return nil, fmt.Errorf("bundled tx %s failed: %s", bundled.Hash, res.VmError)
+				}
+			}
+		default:
+			handler := app.MsgServiceRouter().Handler(msg)
+			if handler == nil {
+				return nil, fmt.Errorf("no message handler found for %s", sdk.MsgTypeURL(msg))
+			}
+			if _, err := handler(msgCtx, msg); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	writeMsgs()
+	return responses, nil
+}
+
+// compareTxResult compares the replayed transaction with its stored result. The status of
+// all the transactions is compared, as it changes the following state, and the gas used
+// and the logs of the Ethereum transactions.
+func compareTxResult(
+	tx sdk.Tx,
+	stored *abci.ExecTxResult,
+	responses []*evmtypes.MsgEthereumTxResponse,
+	replayErr error,
+) error {
+	if stored.IsOK() != (replayErr == nil) {
+		return fmt.Errorf("tx status %t (%v), stored %t (%s)", replayErr == nil, replayErr, stored.IsOK(), stored.Log)
+	}
+
+	ethTxs := ethereumTxs(tx)
+	if len(ethTxs) == 0 || !stored.IsOK() {
+		return nil
+	}
+
+	parsed, err := rpctypes.ParseTxResult(stored, tx)
+	if err != nil {
+		return fmt.Errorf("failed to parse the stored tx result: %w", err)
+	}
+
+	for i, ethTx := range ethTxs {
+		res := responses[i]
+		storedTx := parsed.GetTxByMsgIndex(i)
+		if storedTx == nil {
+			return fmt.Errorf("stored result of eth tx %s not found", ethTx.Hash)
+		}
+
+		if res.Failed() != storedTx.Failed {
+			return fmt.Errorf("eth tx %s failed %t (%s), stored %t", ethTx.Hash, res.Failed(), res.VmError, storedTx.Failed)
+		}
+		if res.GasUsed != storedTx.GasUsed {
+			return fmt.Errorf("eth tx %s gas used %d, stored %d", ethTx.Hash, res.GasUsed, storedTx.GasUsed)
+		}
+
+		storedLogs, err := backend.TxLogsFromEvents(stored.Events, i)
+		if err != nil {
+			return err
+		}
+		if err := compareLogs(evmtypes.LogsToEthereum(res.Logs), storedLogs); err != nil {
+			return fmt.Errorf("eth tx %s %w", ethTx.Hash, err)
+		}
+	}
+
+	return nil
+}
+
+// compareLogs compares the logs by their json encoding.
+func compareLogs(logs, storedLogs []*ethtypes.Log) error {
+	if len(logs) != len(storedLogs) {
+		return fmt.Errorf("emitted %d logs, stored %d", len(logs), len(storedLogs))
+	}
+
+	for i := range logs {
+		bz, err := json.Marshal(logs[i])
+		if err != nil {
+			return err
+		}
+		storedBz, err := json.Marshal(storedLogs[i])
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(bz, storedBz) {
+			return fmt.Errorf("log %d %s, stored %s", i, bz, storedBz)
+		}
+	}
+	return nil
+}
+
+// ethereumTxs returns the Ethereum transactions of the tx, including the bundled ones.
+func ethereumTxs(tx sdk.Tx) []*evmtypes.MsgEthereumTx {
+	var ethTxs []*evmtypes.MsgEthereumTx
+	for _, msg := range evmtypes.UnwrapBundles(tx.GetMsgs()) {
+		if ethTx, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			ethTxs = append(ethTxs, ethTx)
+		}
+	}
+	return ethTxs
+}
+
+// blockBloom returns the bloom of the block bloom event.
+func blockBloom(events []abci.Event) (ethtypes.Bloom, bool) {
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeBlockBloom {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == evmtypes.AttributeKeyEthereumBloom {
+				return ethtypes.BytesToBloom([]byte(attr.Value)), true
+			}
+		}
+	}
+	return ethtypes.Bloom{}, false
+}
+
+// blockGasMeter returns the block gas meter limited by the consensus max gas, like the baseapp.
+func blockGasMeter(consensusParams cmtproto.ConsensusParams) storetypes.GasMeter {
+	if consensusParams.Block != nil && consensusParams.Block.MaxGas > 0 {
+		// #nosec G115 max gas is positive
+		return storetypes.NewGasMeter(uint64(consensusParams.Block.MaxGas))
+	}
+	return storetypes.NewInfiniteGasMeter()
+}
@@ -0,0 +1,271 @@
+package server
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/ethermint/app"
+	"github.com/zeta-chain/ethermint/crypto/ethsecp256k1"
+	"github.com/zeta-chain/ethermint/rpc/backend"
+	"github.com/zeta-chain/ethermint/tests"
+	"github.com/zeta-chain/ethermint/testutil"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+var errInvalidReplay = errors.New("invalid replay")
+
+// replayFixture is a block committed by the test app, deploying an ERC-20 contract and
+// emitting logs from it, with its stored finalize block response.
+type replayFixture struct {
+	app      *app.EthermintApp
+	block    *cmttypes.Block
+	res      *abci.ResponseFinalizeBlock
+	txs      []sdk.Tx
+	contract common.Address
+}
+
+func newReplayFixture(t *testing.T) replayFixture {
+	ethApp := app.Setup(false, nil)
+	ctx := ethApp.NewUncachedContext(false, cmtproto.Header{ChainID: app.ChainID, Height: ethApp.LastBlockHeight() + 1})
+	evmDenom := ethApp.EvmKeeper.GetParams(ctx).EvmDenom
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 1_000_000_000_000_000_000))
+	require.NoError(t, testutil.FundAccount(ethApp.BankKeeper, ctx, from.Bytes(), coins))
+
+	validators, err := ethApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+
+	_, err = ethApp.Commit()
+	require.NoError(t, err)
+
+	// deploy an ERC-20 contract and emit logs from it
+	erc20 := evmtypes.ERC20Contract
+	contract := crypto.CreateAddress(from, 0)
+	ctorArgs, err := erc20.ABI.Pack("", from, big.NewInt(1000))
+	require.NoError(t, err)
+	logsData, err := erc20.ABI.Pack("benchmarkLogs", big.NewInt(2))
+	require.NoError(t, err)
+
+	chainID := ethApp.EvmKeeper.ChainID()
+	msgs := []*evmtypes.MsgEthereumTx{
+		evmtypes.NewTxContract(chainID, 0, nil, 2_000_000, big.NewInt(1_000_000_000), nil, nil, append(erc20.Bin, ctorArgs...), nil),
+		evmtypes.NewTx(chainID, 1, &contract, nil, 200_000, big.NewInt(1_000_000_000), nil, nil, logsData, nil),
+	}
+	var (
+		txs   []sdk.Tx
+		txsBz cmttypes.Txs
+	)
+	for _, msg := range msgs {
+		msg.From = from.Hex()
+		require.NoError(t, msg.Sign(ethtypes.LatestSignerForChainID(chainID), tests.NewSigner(priv)))
+		tx, err := msg.BuildTx(ethApp.TxConfig().NewTxBuilder(), evmDenom)
+		require.NoError(t, err)
+		txBz, err := ethApp.TxConfig().TxEncoder()(tx)
+		require.NoError(t, err)
+		txs = append(txs, tx)
+		txsBz = append(txsBz, txBz)
+	}
+
+	height := ethApp.LastBlockHeight() + 1
+	blk := cmttypes.MakeBlock(height, txsBz, &cmttypes.Commit{}, nil)
+	blk.ChainID = app.ChainID
+	blk.Time = time.Unix(1_700_000_000, 0).UTC()
+	blk.ProposerAddress = consAddr
+	blk.ValidatorsHash = tmhash.Sum(consAddr)
+	blk.NextValidatorsHash = blk.ValidatorsHash
+	blk.AppHash = ethApp.LastCommitID().Hash
+
+	res, err := ethApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Txs:                blk.Txs.ToSliceOfBytes(),
+		Hash:               blk.Hash(),
+		Height:             blk.Height,
+		Time:               blk.Time,
+		NextValidatorsHash: blk.NextValidatorsHash,
+		ProposerAddress:    blk.ProposerAddress,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, len(txs))
+	for _, txRes := range res.TxResults {
+		require.True(t, txRes.IsOK(), txRes.Log)
+	}
+	_, err = ethApp.Commit()
+	require.NoError(t, err)
+
+	return replayFixture{
+		app:      ethApp,
+		block:    blk,
+		res:      res,
+		txs:      txs,
+		contract: contract,
+	}
+}
+
+func TestReplayBlock(t *testing.T) {
+	fixture := newReplayFixture(t)
+
+	testCases := []struct {
+		name     string
+		malleate func(res *abci.ResponseFinalizeBlock)
+		expErr   string
+	}{
+		{"no divergence", func(*abci.ResponseFinalizeBlock) {}, ""},
+		{
+			"missing tx result",
+			func(res *abci.ResponseFinalizeBlock) { res.TxResults = nil },
+			"has 2 txs but 0 tx results",
+		},
+		{
+			"tx status divergence",
+			func(res *abci.ResponseFinalizeBlock) { res.TxResults[1].Code = 1 },
+			"tx status",
+		},
+		{
+			"gas used divergence",
+			func(res *abci.ResponseFinalizeBlock) { res.TxResults[1].GasUsed++ },
+			"gas used",
+		},
+		{
+			"block bloom divergence",
+			func(res *abci.ResponseFinalizeBlock) { res.Events = nil },
+			"block bloom",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := proto.Clone(fixture.res).(*abci.ResponseFinalizeBlock)
+			tc.malleate(res)
+
+			// the replay runs on a cache of the committed state, so every case starts from it
+			evmTxs, err := replayBlock(fixture.app, fixture.block, res, *app.DefaultConsensusParams, abci.CommitInfo{}, log.NewNopLogger())
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 2, evmTxs)
+		})
+	}
+}
+
+func TestCompareTxResult(t *testing.T) {
+	fixture := newReplayFixture(t)
+	stored := fixture.res.TxResults[1]
+	storedLogs, err := backend.TxLogsFromEvents(stored.Events, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, storedLogs)
+
+	testCases := []struct {
+		name     string
+		malleate func(res *evmtypes.MsgEthereumTxResponse, stored *abci.ExecTxResult) error
+		expErr   string
+	}{
+		{
+			"no divergence",
+			func(*evmtypes.MsgEthereumTxResponse, *abci.ExecTxResult) error { return nil },
+			"",
+		},
+		{
+			"both failed",
+			func(_ *evmtypes.MsgEthereumTxResponse, stored *abci.ExecTxResult) error {
+				stored.Code = 1
+				return errInvalidReplay
+			},
+			"",
+		},
+		{
+			"replay failed",
+			func(*evmtypes.MsgEthereumTxResponse, *abci.ExecTxResult) error { return errInvalidReplay },
+			"tx status false",
+		},
+		{
+			"eth tx failed",
+			func(res *evmtypes.MsgEthereumTxResponse, _ *abci.ExecTxResult) error {
+				res.VmError = "execution reverted"
+				return nil
+			},
+			"failed true",
+		},
+		{
+			"gas used",
+			func(res *evmtypes.MsgEthereumTxResponse, _ *abci.ExecTxResult) error {
+				res.GasUsed++
+				return nil
+			},
+			"gas used",
+		},
+		{
+			"missing log",
+			func(res *evmtypes.MsgEthereumTxResponse, _ *abci.ExecTxResult) error {
+				res.Logs = res.Logs[1:]
+				return nil
+			},
+			"logs",
+		},
+		{
+			"log data",
+			func(res *evmtypes.MsgEthereumTxResponse, _ *abci.ExecTxResult) error {
+				res.Logs[0].Data = []byte{1}
+				return nil
+			},
+			"log 0",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// the response matching the stored result
+			res := &evmtypes.MsgEthereumTxResponse{
+				// #nosec G115 gas used always positive
+				GasUsed: uint64(stored.GasUsed),
+				Logs:    evmtypes.NewLogsFromEth(storedLogs),
+			}
+			storedRes := proto.Clone(stored).(*abci.ExecTxResult)
+			replayErr := tc.malleate(res, storedRes)
+
+			err := compareTxResult(fixture.txs[1], storedRes, []*evmtypes.MsgEthereumTxResponse{res}, replayErr)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBlockBloom(t *testing.T) {
+	fixture := newReplayFixture(t)
+
+	bloom, found := blockBloom(fixture.res.Events)
+	require.True(t, found)
+	require.True(t, ethtypes.BloomLookup(bloom, fixture.contract))
+	require.False(t, ethtypes.BloomLookup(bloom, common.BigToAddress(big.NewInt(1))))
+
+	var events []abci.Event
+	for _, event := range fixture.res.Events {
+		if event.Type != evmtypes.EventTypeBlockBloom {
+			events = append(events, event)
+		}
+	}
+	_, found = blockBloom(events)
+	require.False(t, found)
+}
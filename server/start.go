@@ -235,7 +235,15 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 	//nolint:lll
 	cmd.Flags().
-		String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)")
+		String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown or a native tracer)")
+	cmd.Flags().
+		String(srvflags.EVMTracerConfig, "", "the json config of the native EVM tracer")
+	cmd.Flags().
+		String(srvflags.EVMTracerOutputDir, config.DefaultEVMTracerOutputDir, "the directory the native EVM tracer results are written to, one file per block")
+	cmd.Flags().
+		Uint64(srvflags.EVMTracerRetainBlocks, config.DefaultEVMTracerRetainBlocks, "the number of the most recent blocks whose native EVM tracer results are kept (0 keeps all)")
+	cmd.Flags().
+		Uint64(srvflags.EVMTracerMaxSizeMB, config.DefaultEVMTracerMaxSizeMB, "the maximum total size in MB of the native EVM tracer results (0 means no limit)")
 
 	cmd.Flags().
 		Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")
@@ -70,6 +70,7 @@ func AddCommands(
 
 		// custom tx indexer command
 		NewIndexTxCmd(),
+		NewReplayEVMCmd(opts),
 	)
 }
 
@@ -41,3 +41,13 @@ func BlockGasLimit(ctx sdk.Context) uint64 {
 
 	return 0
 }
+
+// BlockTime returns the block time of the context as a unix timestamp, it is used to
+// evaluate the time based forks of the chain config.
+func BlockTime(ctx sdk.Context) uint64 {
+	blockTime := ctx.BlockTime().Unix()
+	if blockTime < 0 {
+		return 0
+	}
+	return uint64(blockTime)
+}
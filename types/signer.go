@@ -7,11 +7,14 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 )
 
-// MakeSigner returns a Signer based on the given chain config and block number.
-// We use this instead of ethtypes.MakeSigner because cosmos always uses blockNumber for hard forks
-func MakeSigner(config *params.ChainConfig, blockNumber *big.Int) ethtypes.Signer {
+// MakeSigner returns a Signer based on the given chain config, block number and block time.
+// We use this instead of ethtypes.MakeSigner because cosmos always uses blockNumber for the
+// hard forks prior to the merge.
+func MakeSigner(config *params.ChainConfig, blockNumber *big.Int, blockTime uint64) ethtypes.Signer {
 	var signer ethtypes.Signer
 	switch {
+	case config.IsCancun(blockNumber, blockTime):
+		signer = ethtypes.NewCancunSigner(config.ChainID)
 	case config.IsLondon(blockNumber):
 		signer = ethtypes.NewLondonSigner(config.ChainID)
 	case config.IsBerlin(blockNumber):
@@ -0,0 +1,148 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/ethermint/x/erc20/types"
+)
+
+// ConvertCoin converts the bank coin of the sender to the ERC-20 tokens of the receiver. The
+// original representation of the token is escrowed or burned according to the conversion mode
+// of the token pair, the other representation is always burned.
+func (k Keeper) ConvertCoin(ctx sdk.Context, sender sdk.AccAddress, receiver common.Address, coin sdk.Coin) (types.TokenPair, error) {
+	pair, err := k.convertibleTokenPair(ctx, coin.Denom)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	coins := sdk.NewCoins(coin)
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
+		return types.TokenPair{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return types.TokenPair{}, err
+	}
+
+	contract := pair.GetERC20Contract()
+	escrow := pair.IsNativeCoin() && pair.ConversionMode == types.CONVERSION_MODE_ESCROW
+	if !escrow {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return types.TokenPair{}, err
+		}
+	}
+
+	if pair.IsNativeERC20() {
+		err = k.transferERC20(ctx, contract, types.ModuleAddress, receiver, coin.Amount.BigInt())
+	} else {
+		_, err = k.callERC20(ctx, types.ModuleAddress, contract, "mint", receiver, coin.Amount.BigInt())
+	}
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertCoin,
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.Hex()),
+			sdk.NewAttribute(types.AttributeKeyAmount, coin.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+	return pair, nil
+}
+
+// ConvertERC20 converts the ERC-20 tokens of the sender to the bank coin of the receiver. The
+// original representation of the token is escrowed or burned according to the conversion mode
+// of the token pair, the other representation is always burned.
+func (k Keeper) ConvertERC20(
+	ctx sdk.Context,
+	sender common.Address,
+	receiver sdk.AccAddress,
+	contract common.Address,
+	amount sdkmath.Int,
+) (types.TokenPair, error) {
+	pair, err := k.convertibleTokenPair(ctx, contract.Hex())
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	if k.bankKeeper.BlockedAddr(receiver) {
+		return types.TokenPair{}, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, amount))
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
+		return types.TokenPair{}, err
+	}
+
+	if pair.IsNativeERC20() {
+		err = k.transferERC20(ctx, contract, sender, types.ModuleAddress, amount.BigInt())
+	} else {
+		_, err = k.callERC20(ctx, sender, contract, "burn", amount.BigInt())
+	}
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	escrow := pair.IsNativeCoin() && pair.ConversionMode == types.CONVERSION_MODE_ESCROW
+	if !escrow {
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return types.TokenPair{}, err
+		}
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		return types.TokenPair{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertERC20,
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.Hex()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+	return pair, nil
+}
+
+// convertibleTokenPair returns the token pair of the token if the conversions are enabled
+func (k Keeper) convertibleTokenPair(ctx sdk.Context, token string) (types.TokenPair, error) {
+	if !k.GetParams(ctx).EnableErc20 {
+		return types.TokenPair{}, types.ErrERC20Disabled
+	}
+
+	pair, err := k.GetTokenPairByToken(ctx, token)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	if !pair.Enabled {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairDisabled, "token %s", token)
+	}
+	return pair, nil
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/ethermint/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestConvertNativeCoin() {
+	testCases := []struct {
+		name        string
+		mode        types.ConversionMode
+		expEscrowed int64
+	}{
+		{"escrow", types.CONVERSION_MODE_ESCROW, 60},
+		{"mint and burn", types.CONVERSION_MODE_MINT_BURN, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			k := suite.app.Erc20Keeper
+			suite.setupCoin(100)
+			sender := sdk.AccAddress(suite.address.Bytes())
+			moduleAddr := sdk.AccAddress(types.ModuleAddress.Bytes())
+
+			pair, err := k.RegisterCoin(suite.ctx, testDenom, tc.mode)
+			suite.Require().NoError(err)
+			contract := pair.GetERC20Contract()
+			supply := suite.app.BankKeeper.GetSupply(suite.ctx, testDenom).Amount
+
+			_, err = k.ConvertCoin(suite.ctx, sender, suite.address, sdk.NewInt64Coin(testDenom, 101))
+			suite.Require().Error(err)
+
+			_, err = k.ConvertCoin(suite.ctx, sender, suite.address, sdk.NewInt64Coin(testDenom, 80))
+			suite.Require().NoError(err)
+			suite.Require().Equal(int64(20), suite.app.BankKeeper.GetBalance(suite.ctx, sender, testDenom).Amount.Int64())
+			suite.Require().Equal(big.NewInt(80), suite.balanceOf(contract, suite.address))
+
+			// only the tokens of the sender can be converted back
+			_, err = k.ConvertERC20(suite.ctx, suite.address, sender, contract, sdkmath.NewInt(81))
+			suite.Require().ErrorIs(err, types.ErrEVMCall)
+
+			_, err = k.ConvertERC20(suite.ctx, suite.address, sender, contract, sdkmath.NewInt(20))
+			suite.Require().NoError(err)
+			suite.Require().Equal(int64(40), suite.app.BankKeeper.GetBalance(suite.ctx, sender, testDenom).Amount.Int64())
+			suite.Require().Equal(big.NewInt(60), suite.balanceOf(contract, suite.address))
+			suite.Require().Equal(big.NewInt(60), suite.call(contract, "totalSupply"))
+
+			suite.Require().Equal(tc.expEscrowed, suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, testDenom).Amount.Int64())
+			suite.Require().Equal(supply.SubRaw(60-tc.expEscrowed), suite.app.BankKeeper.GetSupply(suite.ctx, testDenom).Amount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertNativeERC20() {
+	k := suite.app.Erc20Keeper
+	sender := sdk.AccAddress(suite.address.Bytes())
+
+	contract := suite.deployToken("Test Token", "TST", 1000)
+	pair, err := k.RegisterERC20(suite.ctx, contract, types.CONVERSION_MODE_ESCROW)
+	suite.Require().NoError(err)
+
+	_, err = k.ConvertERC20(suite.ctx, suite.address, sender, contract, sdkmath.NewInt(80))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(80), suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom).Amount.Int64())
+	suite.Require().Equal(big.NewInt(920), suite.balanceOf(contract, suite.address))
+
+	_, err = k.ConvertCoin(suite.ctx, sender, suite.address, sdk.NewInt64Coin(pair.Denom, 20))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(60), suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom).Amount.Int64())
+	suite.Require().Equal(int64(60), suite.app.BankKeeper.GetSupply(suite.ctx, pair.Denom).Amount.Int64())
+	suite.Require().Equal(big.NewInt(940), suite.balanceOf(contract, suite.address))
+
+	// the external tokens are escrowed, the contract supply is unchanged
+	suite.Require().Equal(int64(60), suite.balanceOf(contract, types.ModuleAddress).Int64())
+	suite.Require().Equal(big.NewInt(1000), suite.call(contract, "totalSupply"))
+}
+
+func (suite *KeeperTestSuite) TestConvertDisabled() {
+	k := suite.app.Erc20Keeper
+	suite.setupCoin(100)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	coin := sdk.NewInt64Coin(testDenom, 10)
+
+	_, err := k.ConvertCoin(suite.ctx, sender, suite.address, coin)
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+
+	pair, err := k.RegisterCoin(suite.ctx, testDenom, types.CONVERSION_MODE_ESCROW)
+	suite.Require().NoError(err)
+
+	_, err = k.ToggleConversion(suite.ctx, testDenom)
+	suite.Require().NoError(err)
+	_, err = k.ConvertCoin(suite.ctx, sender, suite.address, coin)
+	suite.Require().ErrorIs(err, types.ErrTokenPairDisabled)
+
+	_, err = k.ToggleConversion(suite.ctx, testDenom)
+	suite.Require().NoError(err)
+	suite.Require().NoError(k.SetParams(suite.ctx, types.NewParams(false, false)))
+	_, err = k.ConvertERC20(suite.ctx, suite.address, sender, pair.GetERC20Contract(), sdkmath.NewInt(10))
+	suite.Require().ErrorIs(err, types.ErrERC20Disabled)
+
+	// the coins can't be sent to a blocked address
+	suite.Require().NoError(k.SetParams(suite.ctx, types.DefaultParams()))
+	blocked := sdk.AccAddress(common.BytesToAddress(suite.app.AccountKeeper.GetModuleAddress("distribution")).Bytes())
+	_, err = k.ConvertERC20(suite.ctx, suite.address, blocked, pair.GetERC20Contract(), sdkmath.NewInt(10))
+	suite.Require().Error(err)
+}
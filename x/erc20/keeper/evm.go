@@ -0,0 +1,287 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/zeta-chain/ethermint/x/erc20/types"
+	"github.com/zeta-chain/ethermint/x/erc20/types/contracts"
+)
+
+// CallGasCap is the gas limit of the calls to the ERC-20 contracts
+const CallGasCap uint64 = 1_000_000
+
+// storage slots of the ERC-20 contract deployed by the module
+const (
+	slotOwner uint64 = iota
+	slotTotalSupply
+	slotDecimals
+	slotName
+	slotSymbol
+)
+
+// DeployERC20Contract deploys the ERC-20 contract of the coin, owned by the module account, and
+// initializes its name, symbol and decimals from the coin metadata. The contract address is
+// derived from the module address and nonce, like a contract creation.
+func (k Keeper) DeployERC20Contract(ctx sdk.Context, metadata banktypes.Metadata) (common.Address, error) {
+	decimals, err := coinDecimals(metadata)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	// the module account must exist before its nonce is increased
+	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	deployer := k.evmKeeper.GetAccountOrEmpty(ctx, types.ModuleAddress)
+	contract := crypto.CreateAddress(types.ModuleAddress, deployer.Nonce)
+
+	account := k.evmKeeper.GetAccountOrEmpty(ctx, contract)
+	if account.Nonce != 0 || account.IsContract() {
+		return common.Address{}, errorsmod.Wrapf(types.ErrEVMCall, "contract address collision %s", contract)
+	}
+
+	code := contracts.ERC20MinterBurnerContract.Bin
+	codeHash := crypto.Keccak256(code)
+	k.evmKeeper.SetCode(ctx, codeHash, code)
+
+	// like a contract creation, the nonce of the new contract starts at 1
+	account.Nonce = 1
+	account.CodeHash = codeHash
+	if err := k.evmKeeper.SetAccount(ctx, contract, account); err != nil {
+		return common.Address{}, errorsmod.Wrap(err, "failed to create the contract account")
+	}
+
+	deployer.Nonce++
+	if err := k.evmKeeper.SetAccount(ctx, types.ModuleAddress, deployer); err != nil {
+		return common.Address{}, errorsmod.Wrap(err, "failed to increase the module nonce")
+	}
+
+	k.setSlot(ctx, contract, slotOwner, common.BytesToHash(types.ModuleAddress.Bytes()))
+	k.setSlot(ctx, contract, slotDecimals, common.BigToHash(big.NewInt(int64(decimals))))
+	name, symbol := coinNameAndSymbol(metadata)
+	k.setString(ctx, contract, slotName, name)
+	k.setString(ctx, contract, slotSymbol, symbol)
+
+	return contract, nil
+}
+
+// setSlot sets the value of the storage slot of the contract
+func (k Keeper) setSlot(ctx sdk.Context, contract common.Address, slot uint64, value common.Hash) {
+	k.evmKeeper.SetState(ctx, contract, common.BigToHash(new(big.Int).SetUint64(slot)), value.Bytes())
+}
+
+// setString stores the string at the slot with the layout of the module contract: the length
+// in the slot and the data in 32 bytes words from the keccak256 hash of the slot.
+func (k Keeper) setString(ctx sdk.Context, contract common.Address, slot uint64, value string) {
+	k.setSlot(ctx, contract, slot, common.BigToHash(big.NewInt(int64(len(value)))))
+
+	dataSlot := crypto.Keccak256Hash(common.BigToHash(new(big.Int).SetUint64(slot)).Bytes()).Big()
+	for i := 0; i < len(value); i += common.HashLength {
+		word := make([]byte, common.HashLength)
+		copy(word, value[i:])
+		key := common.BigToHash(new(big.Int).Add(dataSlot, big.NewInt(int64(i/common.HashLength))))
+		k.evmKeeper.SetState(ctx, contract, key, word)
+	}
+}
+
+// QueryERC20Metadata returns the name, symbol and decimals of the ERC-20 contract
+func (k Keeper) QueryERC20Metadata(ctx sdk.Context, contract common.Address) (name, symbol string, decimals uint8, err error) {
+	erc20 := contracts.ERC20MinterBurnerContract.ABI
+
+	res, err := k.evmKeeper.CallEVM(ctx, types.ModuleAddress, contract, erc20, "name", CallGasCap, false)
+	if err != nil {
+		return "", "", 0, errorsmod.Wrap(types.ErrInvalidMetadata, err.Error())
+	}
+	name, _ = res[0].(string)
+
+	res, err = k.evmKeeper.CallEVM(ctx, types.ModuleAddress, contract, erc20, "symbol", CallGasCap, false)
+	if err != nil {
+		return "", "", 0, errorsmod.Wrap(types.ErrInvalidMetadata, err.Error())
+	}
+	symbol, _ = res[0].(string)
+
+	res, err = k.evmKeeper.CallEVM(ctx, types.ModuleAddress, contract, erc20, "decimals", CallGasCap, false)
+	if err != nil {
+		return "", "", 0, errorsmod.Wrap(types.ErrInvalidMetadata, err.Error())
+	}
+	decimals, _ = res[0].(uint8)
+
+	return name, symbol, decimals, nil
+}
+
+// BalanceOf returns the ERC-20 token balance of the account
+func (k Keeper) BalanceOf(ctx sdk.Context, contract, account common.Address) (*big.Int, error) {
+	erc20 := contracts.ERC20MinterBurnerContract.ABI
+	res, err := k.evmKeeper.CallEVM(ctx, types.ModuleAddress, contract, erc20, "balanceOf", CallGasCap, false, account)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrEVMCall, err.Error())
+	}
+
+	balance, ok := res[0].(*big.Int)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrEVMCall, "invalid balance type %T", res[0])
+	}
+	return balance, nil
+}
+
+// callERC20 executes the ERC-20 method from the given address and persists the state changes.
+// Unlike the evm keeper CallEVM, the nonce of the caller is not increased as it is the signer
+// of the cosmos transaction whose sequence is already increased by the ante handler. The gas
+// used by the call is consumed from the transaction gas meter.
+func (k Keeper) callERC20(
+	ctx sdk.Context,
+	from, contract common.Address,
+	method string,
+	args ...interface{},
+) ([]interface{}, error) {
+	erc20 := contracts.ERC20MinterBurnerContract.ABI
+	data, err := erc20.Pack(method, args...)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to pack the %s method call", method)
+	}
+
+	msg := &core.Message{
+		From:              from,
+		To:                &contract,
+		Nonce:             k.evmKeeper.GetAccountOrEmpty(ctx, from).Nonce,
+		Value:             big.NewInt(0),
+		GasLimit:          CallGasCap,
+		GasPrice:          big.NewInt(0),
+		GasFeeCap:         big.NewInt(0),
+		GasTipCap:         big.NewInt(0),
+		Data:              data,
+		AccessList:        ethtypes.AccessList{},
+		SkipAccountChecks: true,
+	}
+
+	res, err := k.evmKeeper.ApplyMessage(ctx, msg, nil, true)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrEVMCall, "%s: %s", method, err)
+	}
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "erc20 contract call")
+
+	if res.Failed() {
+		if reason, err := abi.UnpackRevert(res.Ret); err == nil {
+			return nil, errorsmod.Wrapf(types.ErrEVMCall, "%s reverted: %s", method, reason)
+		}
+		return nil, errorsmod.Wrapf(types.ErrEVMCall, "%s failed: %s", method, res.VmError)
+	}
+
+	// some tokens don't return a value from the state changing methods
+	if len(res.Ret) == 0 {
+		return nil, nil
+	}
+
+	values, err := erc20.Unpack(method, res.Ret)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrEVMCall, "failed to unpack the %s method result: %s", method, err)
+	}
+	return values, nil
+}
+
+// transferERC20 transfers the ERC-20 tokens from the given address and checks that the recipient
+// balance is increased by the amount, the transfer of the contracts charging fees or rebasing the
+// balances is rejected.
+func (k Keeper) transferERC20(ctx sdk.Context, contract, from, to common.Address, amount *big.Int) error {
+	before, err := k.BalanceOf(ctx, contract, to)
+	if err != nil {
+		return err
+	}
+
+	res, err := k.callERC20(ctx, from, contract, "transfer", to, amount)
+	if err != nil {
+		return err
+	}
+	// the transfer of the tokens not returning a value is only checked with the balance
+	if len(res) > 0 {
+		if ok, _ := res[0].(bool); !ok {
+			return errorsmod.Wrap(types.ErrEVMCall, "transfer returned false")
+		}
+	}
+
+	after, err := k.BalanceOf(ctx, contract, to)
+	if err != nil {
+		return err
+	}
+
+	if received := new(big.Int).Sub(after, before); received.Cmp(amount) != 0 {
+		return errorsmod.Wrapf(types.ErrBalanceInvariance, "expected %s, received %s", amount, received)
+	}
+	return nil
+}
+
+// coinDecimals returns the exponent of the display unit of the coin metadata. The coins
+// without a display unit, e.g. the IBC vouchers displayed with their full denom path while
+// their only unit is the base denom of the trace, fall back to the exponent 0 of the base
+// unit.
+func coinDecimals(metadata banktypes.Metadata) (uint8, error) {
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			if unit.Exponent > math.MaxUint8 {
+				return 0, errorsmod.Wrapf(types.ErrInvalidMetadata, "exponent %d of %s overflows uint8", unit.Exponent, unit.Denom)
+			}
+			return uint8(unit.Exponent), nil
+		}
+	}
+	return 0, nil
+}
+
+// coinNameAndSymbol returns the name and symbol of the coin metadata, falling back to the
+// base denom when they are not set.
+func coinNameAndSymbol(metadata banktypes.Metadata) (name, symbol string) {
+	name, symbol = metadata.Name, metadata.Symbol
+	if name == "" {
+		name = metadata.Base
+	}
+	if symbol == "" {
+		symbol = metadata.Base
+	}
+	return name, symbol
+}
+
+// erc20Metadata returns the bank metadata of the coin representing the external ERC-20 contract
+func erc20Metadata(contract common.Address, name, symbol string, decimals uint8) (banktypes.Metadata, error) {
+	denom := types.CreateDenom(contract)
+	metadata := banktypes.Metadata{
+		Description: fmt.Sprintf("Cosmos coin representation of the ERC-20 contract %s", contract.Hex()),
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        name,
+		Symbol:      symbol,
+	}
+	if decimals > 0 {
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{Denom: symbol, Exponent: uint32(decimals)})
+		metadata.Display = symbol
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return banktypes.Metadata{}, errorsmod.Wrap(types.ErrInvalidMetadata, err.Error())
+	}
+	return metadata, nil
+}
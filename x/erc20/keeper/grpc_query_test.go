@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/zeta-chain/ethermint/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	res, err := suite.queryClient.Params(suite.ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), res.Params)
+}
+
+func (suite *KeeperTestSuite) TestQueryTokenPairs() {
+	suite.setupCoin(0)
+	pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, testDenom, types.CONVERSION_MODE_ESCROW)
+	suite.Require().NoError(err)
+	other, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, suite.deployToken("Test Token", "TST", 0), types.CONVERSION_MODE_ESCROW)
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.TokenPairs(suite.ctx, &types.QueryTokenPairsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]types.TokenPair{pair, other}, res.TokenPairs)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = suite.queryClient.TokenPairs(suite.ctx, &types.QueryTokenPairsRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.TokenPairs, 1)
+	suite.Require().NotEmpty(res.Pagination.NextKey)
+}
+
+func (suite *KeeperTestSuite) TestQueryTokenPair() {
+	suite.setupCoin(0)
+	pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, testDenom, types.CONVERSION_MODE_ESCROW)
+	suite.Require().NoError(err)
+
+	for _, token := range []string{testDenom, pair.Erc20Address} {
+		res, err := suite.queryClient.TokenPair(suite.ctx, &types.QueryTokenPairRequest{Token: token})
+		suite.Require().NoError(err)
+		suite.Require().Equal(pair, res.TokenPair)
+	}
+
+	_, err = suite.queryClient.TokenPair(suite.ctx, &types.QueryTokenPairRequest{Token: "unknown"})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.TokenPair(suite.ctx, &types.QueryTokenPairRequest{Token: "0x"})
+	suite.Require().Error(err)
+}
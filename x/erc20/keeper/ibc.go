@@ -0,0 +1,45 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/ethermint/x/erc20/types"
+)
+
+// RegisterIBCDenom registers the token pair of the IBC voucher received for the first time when
+// the auto registration is enabled. The registration errors are logged and don't affect the
+// transfer, the token pair can still be registered by governance.
+func (k Keeper) RegisterIBCDenom(ctx sdk.Context, denom string) {
+	if !k.GetParams(ctx).AutoRegisterIbcDenoms {
+		return
+	}
+
+	if _, found := k.GetTokenPairByDenom(ctx, denom); found {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	pair, err := k.RegisterCoin(cacheCtx, denom, types.CONVERSION_MODE_ESCROW)
+	if err != nil {
+		k.Logger(ctx).Error("failed to register the IBC denom", "denom", denom, "error", err)
+		return
+	}
+	write()
+
+	k.Logger(ctx).Info("registered the IBC denom", "denom", denom, "erc20", pair.Erc20Address)
+}
@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/zeta-chain/ethermint/app"
+	"github.com/zeta-chain/ethermint/crypto/ethsecp256k1"
+	"github.com/zeta-chain/ethermint/testutil"
+	ethermint "github.com/zeta-chain/ethermint/types"
+	"github.com/zeta-chain/ethermint/x/erc20/types"
+	"github.com/zeta-chain/ethermint/x/erc20/types/contracts"
+)
+
+const testDenom = "uatom"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	app         *app.EthermintApp
+	queryClient types.QueryClient
+	address     common.Address
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	suite.address = common.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	// consensus key of the block proposer
+	consPriv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	consAddress := sdk.ConsAddress(consPriv.PubKey().Address())
+
+	suite.ctx = suite.app.NewUncachedContext(false, tmproto.Header{
+		Height:          1,
+		ChainID:         app.ChainID,
+		Time:            time.Now().UTC(),
+		ProposerAddress: consAddress.Bytes(),
+	}).WithChainID(app.ChainID)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.Erc20Keeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+
+	acc := &ethermint.EthAccount{
+		BaseAccount: authtypes.NewBaseAccount(sdk.AccAddress(suite.address.Bytes()), nil, 0, 0),
+		CodeHash:    common.BytesToHash(crypto.Keccak256(nil)).String(),
+	}
+	acc.AccountNumber = suite.app.AccountKeeper.NextAccountNumber(suite.ctx)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	valAddr := sdk.ValAddress(suite.address.Bytes())
+	validator, err := stakingtypes.NewValidator(valAddr.String(), consPriv.PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+	validator = stakingkeeper.TestingUpdateValidator(suite.app.StakingKeeper, suite.ctx, validator, true)
+	suite.Require().NoError(suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator))
+}
+
+// setupCoin registers the metadata of the test denom and funds the test account
+func (suite *KeeperTestSuite) setupCoin(amount int64) {
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+		Base:    testDenom,
+		Display: "atom",
+		Name:    "Cosmos Hub Atom",
+		Symbol:  "ATOM",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: testDenom, Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+	})
+	coins := sdk.NewCoins(sdk.NewInt64Coin(testDenom, amount))
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, suite.address.Bytes(), coins))
+}
+
+// deployToken deploys a module owned ERC-20 contract, outside of a token pair, and mints the
+// amount to the test account.
+func (suite *KeeperTestSuite) deployToken(name, symbol string, amount int64) common.Address {
+	contract, err := suite.app.Erc20Keeper.DeployERC20Contract(suite.ctx, banktypes.Metadata{
+		Base:       "utest",
+		Display:    "test",
+		Name:       name,
+		Symbol:     symbol,
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "utest", Exponent: 0}, {Denom: "test", Exponent: 18}},
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.app.EvmKeeper.CallEVM(
+		suite.ctx, types.ModuleAddress, contract, contracts.ERC20MinterBurnerContract.ABI, "mint", 1_000_000, true,
+		suite.address, big.NewInt(amount),
+	)
+	suite.Require().NoError(err)
+	return contract
+}
+
+// call executes the method of the module ERC-20 contract without committing the state
+func (suite *KeeperTestSuite) call(contract common.Address, method string, args ...interface{}) interface{} {
+	values, err := suite.app.EvmKeeper.CallEVM(
+		suite.ctx, suite.address, contract, contracts.ERC20MinterBurnerContract.ABI, method, 1_000_000, false, args...,
+	)
+	suite.Require().NoError(err)
+	return values[0]
+}
+
+// balanceOf returns the ERC-20 balance of the account
+func (suite *KeeperTestSuite) balanceOf(contract, account common.Address) *big.Int {
+	balance, err := suite.app.Erc20Keeper.BalanceOf(suite.ctx, contract, account)
+	suite.Require().NoError(err)
+	return balance
+}
+
+func (suite *KeeperTestSuite) TestERC20Contract() {
+	evm := suite.app.EvmKeeper
+	erc20 := contracts.ERC20MinterBurnerContract.ABI
+	gasCap := uint64(1_000_000)
+
+	// the name is stored over several words
+	name := strings.Repeat("transfer/channel-0/", 3) + "uatom IBC token"
+	contract := suite.deployToken(name, "ATOM", 1000)
+
+	suite.Require().Equal(name, suite.call(contract, "name"))
+	suite.Require().Equal("ATOM", suite.call(contract, "symbol"))
+	suite.Require().Equal(uint8(18), suite.call(contract, "decimals"))
+	suite.Require().Equal(types.ModuleAddress, suite.call(contract, "owner"))
+	suite.Require().Equal(big.NewInt(1000), suite.call(contract, "totalSupply"))
+	suite.Require().Equal(big.NewInt(1000), suite.balanceOf(contract, suite.address))
+
+	// only the owner can mint
+	_, err := evm.CallEVM(suite.ctx, suite.address, contract, erc20, "mint", gasCap, true, suite.address, big.NewInt(1))
+	suite.Require().Error(err)
+
+	recipient := common.BigToAddress(big.NewInt(1))
+	_, err = evm.CallEVM(suite.ctx, suite.address, contract, erc20, "transfer", gasCap, true, recipient, big.NewInt(100))
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(900), suite.balanceOf(contract, suite.address))
+	suite.Require().Equal(big.NewInt(100), suite.balanceOf(contract, recipient))
+
+	_, err = evm.CallEVM(suite.ctx, suite.address, contract, erc20, "transfer", gasCap, true, recipient, big.NewInt(901))
+	suite.Require().Error(err)
+	_, err = evm.CallEVM(suite.ctx, suite.address, contract, erc20, "transfer", gasCap, true, common.Address{}, big.NewInt(1))
+	suite.Require().Error(err)
+
+	// transferFrom spends the allowance
+	spender := common.BytesToAddress(authtypes.NewModuleAddress("spender"))
+	_, err = evm.CallEVM(suite.ctx, suite.address, contract, erc20, "approve", gasCap, true, spender, big.NewInt(50))
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(50), suite.call(contract, "allowance", suite.address, spender))
+
+	_, err = evm.CallEVM(suite.ctx, spender, contract, erc20, "transferFrom", gasCap, true, suite.address, recipient, big.NewInt(51))
+	suite.Require().Error(err)
+	_, err = evm.CallEVM(suite.ctx, spender, contract, erc20, "transferFrom", gasCap, true, suite.address, recipient, big.NewInt(30))
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(20), suite.call(contract, "allowance", suite.address, spender))
+	suite.Require().Equal(big.NewInt(130), suite.balanceOf(contract, recipient))
+
+	// burn decreases the balance and the total supply
+	_, err = evm.CallEVM(suite.ctx, suite.address, contract, erc20, "burn", gasCap, true, big.NewInt(70))
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(800), suite.balanceOf(contract, suite.address))
+	suite.Require().Equal(big.NewInt(930), suite.call(contract, "totalSupply"))
+	_, err = evm.CallEVM(suite.ctx, suite.address, contract, erc20, "burn", gasCap, true, big.NewInt(801))
+	suite.Require().Error(err)
+
+	// the contract isn't payable
+	res, err := evm.CallEVMWithData(suite.ctx, suite.address, &contract, nil, gasCap, false)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Failed())
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	k := suite.app.Erc20Keeper
+	suite.Require().Equal(types.DefaultParams(), k.GetParams(suite.ctx))
+
+	params := types.NewParams(false, true)
+	suite.Require().NoError(k.SetParams(suite.ctx, params))
+	suite.Require().Equal(params, k.GetParams(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestTokenPairs() {
+	k := suite.app.Erc20Keeper
+	contract := common.BigToAddress(big.NewInt(1))
+	pair := types.NewTokenPair(contract, testDenom, types.OWNER_MODULE, types.CONVERSION_MODE_ESCROW)
+
+	_, found := k.GetTokenPair(suite.ctx, contract)
+	suite.Require().False(found)
+	suite.Require().False(k.IsTokenPairRegistered(suite.ctx, contract, testDenom))
+
+	k.SetTokenPair(suite.ctx, pair)
+
+	res, found := k.GetTokenPair(suite.ctx, contract)
+	suite.Require().True(found)
+	suite.Require().Equal(pair, res)
+
+	res, found = k.GetTokenPairByDenom(suite.ctx, testDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(pair, res)
+
+	res, err := k.GetTokenPairByToken(suite.ctx, contract.Hex())
+	suite.Require().NoError(err)
+	suite.Require().Equal(pair, res)
+
+	_, err = k.GetTokenPairByToken(suite.ctx, "unknown")
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+
+	suite.Require().True(k.IsTokenPairRegistered(suite.ctx, common.Address{}, testDenom))
+	suite.Require().Equal([]types.TokenPair{pair}, k.GetTokenPairs(suite.ctx))
+}
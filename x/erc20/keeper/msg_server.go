@@ -0,0 +1,142 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/ethermint/x/erc20/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the erc20 MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return msgServer{Keeper: k}
+}
+
+// ConvertCoin implements the gRPC MsgServer interface. It converts the native Cosmos coin of
+// the sender to the ERC-20 tokens of the receiver.
+func (k msgServer) ConvertCoin(goCtx context.Context, msg *types.MsgConvertCoin) (*types.MsgConvertCoinResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if _, err := k.Keeper.ConvertCoin(ctx, sender, common.HexToAddress(msg.Receiver), msg.Coin); err != nil {
+		return nil, err
+	}
+	return &types.MsgConvertCoinResponse{}, nil
+}
+
+// ConvertERC20 implements the gRPC MsgServer interface. It converts the ERC-20 tokens of the
+// sender to the native Cosmos coins of the receiver.
+func (k msgServer) ConvertERC20(goCtx context.Context, msg *types.MsgConvertERC20) (*types.MsgConvertERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid receiver address")
+	}
+
+	contract := common.HexToAddress(msg.ContractAddress)
+	if _, err := k.Keeper.ConvertERC20(ctx, common.BytesToAddress(sender), receiver, contract, msg.Amount); err != nil {
+		return nil, err
+	}
+	return &types.MsgConvertERC20Response{}, nil
+}
+
+// RegisterCoin implements the gRPC MsgServer interface. When a RegisterCoin proposal passes,
+// it deploys the ERC-20 contract of the coin and registers the token pair.
+func (k msgServer) RegisterCoin(goCtx context.Context, msg *types.MsgRegisterCoin) (*types.MsgRegisterCoinResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	pair, err := k.Keeper.RegisterCoin(sdk.UnwrapSDKContext(goCtx), msg.Denom, msg.ConversionMode)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterCoinResponse{TokenPair: pair}, nil
+}
+
+// RegisterERC20 implements the gRPC MsgServer interface. When a RegisterERC20 proposal passes,
+// it registers the token pair of the ERC-20 contract.
+func (k msgServer) RegisterERC20(goCtx context.Context, msg *types.MsgRegisterERC20) (*types.MsgRegisterERC20Response, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	contract := common.HexToAddress(msg.Erc20Address)
+	pair, err := k.Keeper.RegisterERC20(sdk.UnwrapSDKContext(goCtx), contract, msg.ConversionMode)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterERC20Response{TokenPair: pair}, nil
+}
+
+// ToggleConversion implements the gRPC MsgServer interface. When a ToggleConversion proposal
+// passes, it enables or disables the conversion of the token pair.
+func (k msgServer) ToggleConversion(goCtx context.Context, msg *types.MsgToggleConversion) (*types.MsgToggleConversionResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.Keeper.ToggleConversion(sdk.UnwrapSDKContext(goCtx), msg.Token); err != nil {
+		return nil, err
+	}
+	return &types.MsgToggleConversionResponse{}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
+// proposal passes, it updates the module parameters. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetParams(sdk.UnwrapSDKContext(goCtx), msg.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// checkAuthority returns an error if the signer isn't the module authority
+func (k msgServer) checkAuthority(authority string) error {
+	if k.authority.String() != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), authority)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/zeta-chain/ethermint/x/erc20/keeper"
+	"github.com/zeta-chain/ethermint/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgServer() {
+	suite.setupCoin(100)
+	msgServer := keeper.NewMsgServerImpl(suite.app.Erc20Keeper)
+	authority := suite.app.Erc20Keeper.GetAuthority().String()
+	sender := sdk.AccAddress(suite.address.Bytes())
+
+	// the governance messages require the authority
+	_, err := msgServer.RegisterCoin(suite.ctx, &types.MsgRegisterCoin{Authority: sender.String(), Denom: testDenom})
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = msgServer.UpdateParams(suite.ctx, &types.MsgUpdateParams{Authority: sender.String(), Params: types.DefaultParams()})
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	res, err := msgServer.RegisterCoin(suite.ctx, &types.MsgRegisterCoin{Authority: authority, Denom: testDenom})
+	suite.Require().NoError(err)
+	contract := res.TokenPair.GetERC20Contract()
+
+	_, err = msgServer.ConvertCoin(suite.ctx, types.NewMsgConvertCoin(sdk.NewInt64Coin(testDenom, 30), suite.address, sender))
+	suite.Require().NoError(err)
+
+	_, err = msgServer.ConvertERC20(suite.ctx, &types.MsgConvertERC20{
+		ContractAddress: contract.Hex(),
+		Amount:          sdkmath.NewInt(10),
+		Receiver:        sender.String(),
+		Sender:          sender.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(20), suite.balanceOf(contract, suite.address).Int64())
+
+	// the nonce of the sender isn't increased by the conversions
+	suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+
+	_, err = msgServer.ToggleConversion(suite.ctx, &types.MsgToggleConversion{Authority: authority, Token: testDenom})
+	suite.Require().NoError(err)
+
+	_, err = msgServer.UpdateParams(suite.ctx, &types.MsgUpdateParams{Authority: authority, Params: types.NewParams(false, true)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewParams(false, true), suite.app.Erc20Keeper.GetParams(suite.ctx))
+}
@@ -0,0 +1,124 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/ethermint/x/erc20/types"
+)
+
+// RegisterCoin deploys the ERC-20 contract of the bank coin and registers the token pair. The
+// coin metadata must be registered in the bank module.
+func (k Keeper) RegisterCoin(ctx sdk.Context, denom string, mode types.ConversionMode) (types.TokenPair, error) {
+	if types.IsERC20Denom(denom) {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairAlreadyExists, "denom %s is the representation of an ERC-20 contract", denom)
+	}
+
+	if _, found := k.GetTokenPairByDenom(ctx, denom); found {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairAlreadyExists, "denom %s", denom)
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrMetadataNotFound, "denom %s", denom)
+	}
+
+	contract, err := k.DeployERC20Contract(ctx, metadata)
+	if err != nil {
+		return types.TokenPair{}, errorsmod.Wrapf(err, "failed to deploy the ERC-20 contract of %s", denom)
+	}
+
+	pair := types.NewTokenPair(contract, denom, types.OWNER_MODULE, mode)
+	k.SetTokenPair(ctx, pair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterCoin,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyConversionMode, pair.ConversionMode.String()),
+		),
+	)
+	return pair, nil
+}
+
+// RegisterERC20 registers the token pair of the external ERC-20 contract, its bank coin
+// metadata is created from the contract name, symbol and decimals.
+func (k Keeper) RegisterERC20(ctx sdk.Context, contract common.Address, mode types.ConversionMode) (types.TokenPair, error) {
+	if err := types.ValidateExternalConversionMode(mode); err != nil {
+		return types.TokenPair{}, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	denom := types.CreateDenom(contract)
+	if k.IsTokenPairRegistered(ctx, contract, denom) {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairAlreadyExists, "contract %s", contract)
+	}
+
+	if account := k.evmKeeper.GetAccount(ctx, contract); account == nil || !account.IsContract() {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrInvalidMetadata, "%s is not a contract", contract)
+	}
+
+	name, symbol, decimals, err := k.QueryERC20Metadata(ctx, contract)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	metadata, err := erc20Metadata(contract, name, symbol, decimals)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	pair := types.NewTokenPair(contract, denom, types.OWNER_EXTERNAL, mode)
+	k.SetTokenPair(ctx, pair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterERC20,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyConversionMode, pair.ConversionMode.String()),
+		),
+	)
+	return pair, nil
+}
+
+// ToggleConversion enables or disables the conversion of the token pair
+func (k Keeper) ToggleConversion(ctx sdk.Context, token string) (types.TokenPair, error) {
+	pair, err := k.GetTokenPairByToken(ctx, token)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	pair.Enabled = !pair.Enabled
+	k.SetTokenPair(ctx, pair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeToggleConversion,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(pair.Enabled)),
+		),
+	)
+	return pair, nil
+}
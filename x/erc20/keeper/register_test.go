@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/ethermint/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterCoin() {
+	k := suite.app.Erc20Keeper
+
+	_, err := k.RegisterCoin(suite.ctx, testDenom, types.CONVERSION_MODE_ESCROW)
+	suite.Require().ErrorIs(err, types.ErrMetadataNotFound)
+
+	suite.setupCoin(0)
+	pair, err := k.RegisterCoin(suite.ctx, testDenom, types.CONVERSION_MODE_MINT_BURN)
+	suite.Require().NoError(err)
+	suite.Require().Equal(testDenom, pair.Denom)
+	suite.Require().True(pair.Enabled)
+	suite.Require().Equal(types.OWNER_MODULE, pair.ContractOwner)
+	suite.Require().Equal(types.CONVERSION_MODE_MINT_BURN, pair.ConversionMode)
+
+	contract := pair.GetERC20Contract()
+	suite.Require().Equal("Cosmos Hub Atom", suite.call(contract, "name"))
+	suite.Require().Equal("ATOM", suite.call(contract, "symbol"))
+	suite.Require().Equal(uint8(6), suite.call(contract, "decimals"))
+
+	res, found := k.GetTokenPairByDenom(suite.ctx, testDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(pair, res)
+
+	_, err = k.RegisterCoin(suite.ctx, testDenom, types.CONVERSION_MODE_ESCROW)
+	suite.Require().ErrorIs(err, types.ErrTokenPairAlreadyExists)
+
+	_, err = k.RegisterCoin(suite.ctx, types.CreateDenom(contract), types.CONVERSION_MODE_ESCROW)
+	suite.Require().ErrorIs(err, types.ErrTokenPairAlreadyExists)
+}
+
+func (suite *KeeperTestSuite) TestRegisterERC20() {
+	k := suite.app.Erc20Keeper
+
+	_, err := k.RegisterERC20(suite.ctx, common.BigToAddress(big.NewInt(1)), types.CONVERSION_MODE_ESCROW)
+	suite.Require().ErrorIs(err, types.ErrInvalidMetadata)
+
+	contract := suite.deployToken("Test Token", "TST", 1000)
+
+	// the module can't mint and burn the tokens of an external contract
+	_, err = k.RegisterERC20(suite.ctx, contract, types.CONVERSION_MODE_MINT_BURN)
+	suite.Require().Error(err)
+
+	pair, err := k.RegisterERC20(suite.ctx, contract, types.CONVERSION_MODE_ESCROW)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.CreateDenom(contract), pair.Denom)
+	suite.Require().Equal(types.OWNER_EXTERNAL, pair.ContractOwner)
+
+	metadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, pair.Denom)
+	suite.Require().True(found)
+	suite.Require().Equal("Test Token", metadata.Name)
+	suite.Require().Equal("TST", metadata.Display)
+	suite.Require().Equal(uint32(18), metadata.DenomUnits[1].Exponent)
+
+	_, err = k.RegisterERC20(suite.ctx, contract, types.CONVERSION_MODE_ESCROW)
+	suite.Require().ErrorIs(err, types.ErrTokenPairAlreadyExists)
+}
+
+func (suite *KeeperTestSuite) TestToggleConversion() {
+	k := suite.app.Erc20Keeper
+	suite.setupCoin(0)
+	pair, err := k.RegisterCoin(suite.ctx, testDenom, types.CONVERSION_MODE_ESCROW)
+	suite.Require().NoError(err)
+
+	pair, err = k.ToggleConversion(suite.ctx, pair.Erc20Address)
+	suite.Require().NoError(err)
+	suite.Require().False(pair.Enabled)
+
+	pair, err = k.ToggleConversion(suite.ctx, testDenom)
+	suite.Require().NoError(err)
+	suite.Require().True(pair.Enabled)
+
+	_, err = k.ToggleConversion(suite.ctx, "unknown")
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+}
+
+func (suite *KeeperTestSuite) TestRegisterIBCDenom() {
+	k := suite.app.Erc20Keeper
+	suite.setupCoin(0)
+
+	// the auto registration is disabled by default
+	k.RegisterIBCDenom(suite.ctx, testDenom)
+	_, found := k.GetTokenPairByDenom(suite.ctx, testDenom)
+	suite.Require().False(found)
+
+	suite.Require().NoError(k.SetParams(suite.ctx, types.NewParams(true, true)))
+
+	// the registration errors are ignored
+	k.RegisterIBCDenom(suite.ctx, "ibc/unknown")
+	_, found = k.GetTokenPairByDenom(suite.ctx, "ibc/unknown")
+	suite.Require().False(found)
+
+	k.RegisterIBCDenom(suite.ctx, testDenom)
+	pair, found := k.GetTokenPairByDenom(suite.ctx, testDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(types.CONVERSION_MODE_ESCROW, pair.ConversionMode)
+
+	// the registered denom is unchanged
+	k.RegisterIBCDenom(suite.ctx, testDenom)
+	res, found := k.GetTokenPairByDenom(suite.ctx, testDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(pair, res)
+}
+
+func (suite *KeeperTestSuite) TestRegisterIBCVoucher() {
+	k := suite.app.Erc20Keeper
+	suite.Require().NoError(k.SetParams(suite.ctx, types.NewParams(true, true)))
+
+	// the metadata written by the ibc transfer module on the first receive of a voucher
+	trace := transfertypes.ParseDenomTrace("transfer/channel-0/uatom")
+	denom := trace.IBCDenom()
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+		Description: fmt.Sprintf("IBC token from %s", trace.GetFullDenomPath()),
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: trace.BaseDenom, Exponent: 0}},
+		Base:        denom,
+		Display:     trace.GetFullDenomPath(),
+		Name:        fmt.Sprintf("%s IBC token", trace.GetFullDenomPath()),
+		Symbol:      strings.ToUpper(trace.BaseDenom),
+	})
+
+	k.RegisterIBCDenom(suite.ctx, denom)
+	pair, found := k.GetTokenPairByDenom(suite.ctx, denom)
+	suite.Require().True(found)
+
+	contract := pair.GetERC20Contract()
+	suite.Require().Equal("transfer/channel-0/uatom IBC token", suite.call(contract, "name"))
+	suite.Require().Equal("UATOM", suite.call(contract, "symbol"))
+	suite.Require().Equal(uint8(0), suite.call(contract, "decimals"))
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/ethermint/x/erc20/types"
+)
+
+// GetTokenPair returns the token pair of the ERC-20 contract
+func (k Keeper) GetTokenPair(ctx sdk.Context, contract common.Address) (types.TokenPair, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.TokenPairKey(contract))
+	if bz == nil {
+		return types.TokenPair{}, false
+	}
+
+	var pair types.TokenPair
+	k.cdc.MustUnmarshal(bz, &pair)
+	return pair, true
+}
+
+// GetTokenPairByDenom returns the token pair of the bank denom
+func (k Keeper) GetTokenPairByDenom(ctx sdk.Context, denom string) (types.TokenPair, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.TokenPairByDenomKey(denom))
+	if bz == nil {
+		return types.TokenPair{}, false
+	}
+	return k.GetTokenPair(ctx, common.BytesToAddress(bz))
+}
+
+// GetTokenPairByToken returns the token pair of the token, either the hex address of the ERC-20
+// contract or the bank denom.
+func (k Keeper) GetTokenPairByToken(ctx sdk.Context, token string) (types.TokenPair, error) {
+	var (
+		pair  types.TokenPair
+		found bool
+	)
+	if common.IsHexAddress(token) {
+		pair, found = k.GetTokenPair(ctx, common.HexToAddress(token))
+	} else {
+		pair, found = k.GetTokenPairByDenom(ctx, token)
+	}
+
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairNotFound, "token %s", token)
+	}
+	return pair, nil
+}
+
+// SetTokenPair stores the token pair and indexes it by denom
+func (k Keeper) SetTokenPair(ctx sdk.Context, pair types.TokenPair) {
+	contract := pair.GetERC20Contract()
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TokenPairKey(contract), k.cdc.MustMarshal(&pair))
+	store.Set(types.TokenPairByDenomKey(pair.Denom), contract.Bytes())
+}
+
+// IsTokenPairRegistered returns true if the ERC-20 contract or the denom is registered in a
+// token pair
+func (k Keeper) IsTokenPairRegistered(ctx sdk.Context, contract common.Address, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.TokenPairKey(contract)) || store.Has(types.TokenPairByDenomKey(denom))
+}
+
+// IterateTokenPairs iterates over the token pairs, the iteration stops if the callback returns true
+func (k Keeper) IterateTokenPairs(ctx sdk.Context, cb func(pair types.TokenPair) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pair types.TokenPair
+		k.cdc.MustUnmarshal(iterator.Value(), &pair)
+		if cb(pair) {
+			break
+		}
+	}
+}
+
+// GetTokenPairs returns all the registered token pairs
+func (k Keeper) GetTokenPairs(ctx sdk.Context) []types.TokenPair {
+	pairs := []types.TokenPair{}
+	k.IterateTokenPairs(ctx, func(pair types.TokenPair) bool {
+		pairs = append(pairs, pair)
+		return false
+	})
+	return pairs
+}
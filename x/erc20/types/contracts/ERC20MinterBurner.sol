@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity 0.8.24;
+
+/// @title ERC20MinterBurner
+/// @notice ERC-20 contract deployed by the erc20 module for the native Cosmos coins. The
+/// contract is owned by the module, which is the only account allowed to mint tokens; any
+/// holder can burn its own tokens.
+/// @dev The contract has no constructor, its storage is initialized by the module when the
+/// contract is deployed. The storage layout is shared with the module and must not change:
+///   0: owner
+///   1: total supply
+///   2: decimals
+///   3: name length, the name is stored in 32 bytes words from keccak256(3)
+///   4: symbol length, the symbol is stored in 32 bytes words from keccak256(4)
+///   keccak256(account . 5): balance of the account
+///   keccak256(owner . spender . 6): allowance of the spender
+/// The strings and the allowances don't use the Solidity layout and are accessed in assembly.
+contract ERC20MinterBurner {
+    address private _owner;
+    uint256 private _totalSupply;
+    uint8 private _decimals;
+    uint256 private _nameLength;
+    uint256 private _symbolLength;
+    mapping(address => uint256) private _balances;
+    uint256 private _allowances;
+
+    event Transfer(address indexed from, address indexed to, uint256 value);
+    event Approval(address indexed owner, address indexed spender, uint256 value);
+
+    function name() external view returns (string memory) {
+        return _loadString(3);
+    }
+
+    function symbol() external view returns (string memory) {
+        return _loadString(4);
+    }
+
+    function decimals() external view returns (uint8) {
+        return _decimals;
+    }
+
+    function totalSupply() external view returns (uint256) {
+        return _totalSupply;
+    }
+
+    function balanceOf(address account) external view returns (uint256) {
+        return _balances[account];
+    }
+
+    function allowance(address holder, address spender) external view returns (uint256 value) {
+        uint256 slot = _allowanceSlot(holder, spender);
+        assembly {
+            value := sload(slot)
+        }
+    }
+
+    function transfer(address to, uint256 amount) external returns (bool) {
+        _transfer(msg.sender, to, amount);
+        return true;
+    }
+
+    function approve(address spender, uint256 amount) external returns (bool) {
+        if (spender == address(0)) revert();
+        uint256 slot = _allowanceSlot(msg.sender, spender);
+        assembly {
+            sstore(slot, amount)
+        }
+        emit Approval(msg.sender, spender, amount);
+        return true;
+    }
+
+    function transferFrom(address from, address to, uint256 amount) external returns (bool) {
+        uint256 slot = _allowanceSlot(from, msg.sender);
+        uint256 allowed;
+        assembly {
+            allowed := sload(slot)
+        }
+        // the max allowance is never decreased
+        if (allowed != type(uint256).max) {
+            if (amount > allowed) revert();
+            unchecked {
+                allowed -= amount;
+            }
+            assembly {
+                sstore(slot, allowed)
+            }
+        }
+        _transfer(from, to, amount);
+        return true;
+    }
+
+    function mint(address to, uint256 amount) external returns (bool) {
+        if (msg.sender != _owner) revert();
+        if (to == address(0)) revert();
+        uint256 supply;
+        unchecked {
+            supply = _totalSupply + amount;
+        }
+        if (supply < _totalSupply) revert();
+        _totalSupply = supply;
+        unchecked {
+            // can't overflow as the balance is bounded by the total supply
+            _balances[to] += amount;
+        }
+        emit Transfer(address(0), to, amount);
+        return true;
+    }
+
+    function burn(uint256 amount) external {
+        uint256 balance = _balances[msg.sender];
+        if (amount > balance) revert();
+        unchecked {
+            _balances[msg.sender] = balance - amount;
+            // can't underflow as the balance was enough
+            _totalSupply -= amount;
+        }
+        emit Transfer(msg.sender, address(0), amount);
+    }
+
+    function owner() external view returns (address) {
+        return _owner;
+    }
+
+    function _transfer(address from, address to, uint256 amount) private {
+        if (to == address(0)) revert();
+        uint256 balance = _balances[from];
+        if (amount > balance) revert();
+        unchecked {
+            _balances[from] = balance - amount;
+            // can't overflow as the balance is bounded by the total supply
+            _balances[to] += amount;
+        }
+        emit Transfer(from, to, amount);
+    }
+
+    function _allowanceSlot(address holder, address spender) private pure returns (uint256 slot) {
+        assembly {
+            let ptr := mload(0x40)
+            mstore(ptr, holder)
+            mstore(add(ptr, 0x20), spender)
+            mstore(add(ptr, 0x40), 6)
+            slot := keccak256(ptr, 0x60)
+        }
+    }
+
+    function _loadString(uint256 slot) private view returns (string memory value) {
+        uint256 length;
+        assembly {
+            length := sload(slot)
+        }
+        value = new string(length);
+        assembly {
+            mstore(0, slot)
+            let dataSlot := keccak256(0, 0x20)
+            let words := shr(5, add(length, 31))
+            for { let i := 0 } lt(i, words) { i := add(i, 1) } {
+                mstore(add(add(value, 0x20), shl(5, i)), sload(add(dataSlot, i)))
+            }
+        }
+    }
+}
@@ -0,0 +1,44 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package contracts
+
+import (
+	// embed the compiled contract
+	_ "embed"
+	"encoding/json"
+
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed ERC20MinterBurner.json
+	erc20MinterBurnerJSON []byte
+
+	// ERC20MinterBurnerContract is the ERC-20 contract deployed by the module for the native
+	// Cosmos coins, compiled by `make contracts-compile`. Its Bin is the runtime code, the
+	// contract has no constructor as its storage is initialized by the module.
+	ERC20MinterBurnerContract evmtypes.CompiledContract
+)
+
+func init() {
+	if err := json.Unmarshal(erc20MinterBurnerJSON, &ERC20MinterBurnerContract); err != nil {
+		panic(err)
+	}
+
+	if len(ERC20MinterBurnerContract.Bin) == 0 {
+		panic("load contract failed")
+	}
+}
@@ -0,0 +1,813 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ethermint/erc20/v1/erc20.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Owner enumerates the ownership of the ERC-20 contract of a token pair.
+type Owner int32
+
+const (
+	// OWNER_UNSPECIFIED defines an invalid/undefined owner.
+	OWNER_UNSPECIFIED Owner = 0
+	// OWNER_MODULE the ERC-20 contract is deployed by the module for a native bank coin.
+	OWNER_MODULE Owner = 1
+	// OWNER_EXTERNAL the ERC-20 contract is an external contract, the bank coin is
+	// created by the module.
+	OWNER_EXTERNAL Owner = 2
+)
+
+var Owner_name = map[int32]string{
+	0: "OWNER_UNSPECIFIED",
+	1: "OWNER_MODULE",
+	2: "OWNER_EXTERNAL",
+}
+
+var Owner_value = map[string]int32{
+	"OWNER_UNSPECIFIED": 0,
+	"OWNER_MODULE":      1,
+	"OWNER_EXTERNAL":    2,
+}
+
+func (x Owner) String() string {
+	return proto.EnumName(Owner_name, int32(x))
+}
+
+func (Owner) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_038a52a4564e16dc, []int{0}
+}
+
+// ConversionMode enumerates how the original representation of a token pair is
+// handled when it is converted to the other representation.
+type ConversionMode int32
+
+const (
+	// CONVERSION_MODE_ESCROW locks the original tokens in the module account and
+	// releases them on the conversion back.
+	CONVERSION_MODE_ESCROW ConversionMode = 0
+	// CONVERSION_MODE_MINT_BURN burns the original tokens and mints them on the
+	// conversion back. It is only supported for the bank coins, the external
+	// ERC-20 contracts are always escrowed.
+	CONVERSION_MODE_MINT_BURN ConversionMode = 1
+)
+
+var ConversionMode_name = map[int32]string{
+	0: "CONVERSION_MODE_ESCROW",
+	1: "CONVERSION_MODE_MINT_BURN",
+}
+
+var ConversionMode_value = map[string]int32{
+	"CONVERSION_MODE_ESCROW":    0,
+	"CONVERSION_MODE_MINT_BURN": 1,
+}
+
+func (x ConversionMode) String() string {
+	return proto.EnumName(ConversionMode_name, int32(x))
+}
+
+func (ConversionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_038a52a4564e16dc, []int{1}
+}
+
+// TokenPair defines an instance that records a pairing consisting of a native
+// Cosmos Coin and an ERC-20 token address.
+type TokenPair struct {
+	// erc20_address is the hex address of the ERC-20 contract
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// denom defines the cosmos base denomination to be mapped to
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// enabled defines the token mapping enable status
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// contract_owner is the owner of the ERC-20 contract
+	ContractOwner Owner `protobuf:"varint,4,opt,name=contract_owner,json=contractOwner,proto3,enum=ethermint.erc20.v1.Owner" json:"contract_owner,omitempty"`
+	// conversion_mode defines how the original representation is converted
+	ConversionMode ConversionMode `protobuf:"varint,5,opt,name=conversion_mode,json=conversionMode,proto3,enum=ethermint.erc20.v1.ConversionMode" json:"conversion_mode,omitempty"`
+}
+
+func (m *TokenPair) Reset()         { *m = TokenPair{} }
+func (m *TokenPair) String() string { return proto.CompactTextString(m) }
+func (*TokenPair) ProtoMessage()    {}
+func (*TokenPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_038a52a4564e16dc, []int{0}
+}
+func (m *TokenPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPair.Merge(m, src)
+}
+func (m *TokenPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPair proto.InternalMessageInfo
+
+func (m *TokenPair) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *TokenPair) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TokenPair) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *TokenPair) GetContractOwner() Owner {
+	if m != nil {
+		return m.ContractOwner
+	}
+	return OWNER_UNSPECIFIED
+}
+
+func (m *TokenPair) GetConversionMode() ConversionMode {
+	if m != nil {
+		return m.ConversionMode
+	}
+	return CONVERSION_MODE_ESCROW
+}
+
+// Params defines the erc20 module parameters
+type Params struct {
+	// enable_erc20 is the parameter to enable the conversion of the registered
+	// token pairs.
+	EnableErc20 bool `protobuf:"varint,1,opt,name=enable_erc20,json=enableErc20,proto3" json:"enable_erc20,omitempty"`
+	// auto_register_ibc_denoms registers a token pair, deploying its ERC-20
+	// contract, for the IBC vouchers received for the first time.
+	AutoRegisterIbcDenoms bool `protobuf:"varint,2,opt,name=auto_register_ibc_denoms,json=autoRegisterIbcDenoms,proto3" json:"auto_register_ibc_denoms,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_038a52a4564e16dc, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnableErc20() bool {
+	if m != nil {
+		return m.EnableErc20
+	}
+	return false
+}
+
+func (m *Params) GetAutoRegisterIbcDenoms() bool {
+	if m != nil {
+		return m.AutoRegisterIbcDenoms
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("ethermint.erc20.v1.Owner", Owner_name, Owner_value)
+	proto.RegisterEnum("ethermint.erc20.v1.ConversionMode", ConversionMode_name, ConversionMode_value)
+	proto.RegisterType((*TokenPair)(nil), "ethermint.erc20.v1.TokenPair")
+	proto.RegisterType((*Params)(nil), "ethermint.erc20.v1.Params")
+}
+
+func init() { proto.RegisterFile("ethermint/erc20/v1/erc20.proto", fileDescriptor_038a52a4564e16dc) }
+
+var fileDescriptor_038a52a4564e16dc = []byte{
+	// 468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0x8e, 0x47, 0x3b, 0x3a, 0xd3, 0x85, 0x60, 0x6d, 0x28, 0xab, 0x44, 0x28, 0xe5, 0x52, 0x4d,
+	0x5a, 0xc2, 0xc6, 0x01, 0x89, 0x13, 0x5b, 0x6b, 0x50, 0x60, 0x4d, 0x8a, 0xdb, 0x32, 0xc4, 0xc5,
+	0xca, 0x87, 0xd5, 0x46, 0x90, 0x78, 0x72, 0xbc, 0xf2, 0xf1, 0x0b, 0x38, 0xf2, 0x13, 0x90, 0xf8,
+	0x33, 0x1c, 0x77, 0xe4, 0x88, 0xda, 0x0b, 0x7f, 0x80, 0x3b, 0x8a, 0xbd, 0x0d, 0x0d, 0x76, 0x7b,
+	0x9f, 0x0f, 0x3f, 0x7a, 0x1f, 0xeb, 0x85, 0x0e, 0x93, 0x33, 0x26, 0xf2, 0xac, 0x90, 0x1e, 0x13,
+	0xc9, 0xde, 0x03, 0x6f, 0xbe, 0xab, 0x07, 0xf7, 0x58, 0x70, 0xc9, 0x11, 0xba, 0xd0, 0x5d, 0x4d,
+	0xcf, 0x77, 0x5b, 0x1b, 0x53, 0x3e, 0xe5, 0x4a, 0xf6, 0xaa, 0x49, 0x3b, 0x3b, 0xbf, 0x01, 0x5c,
+	0x1b, 0xf3, 0xb7, 0xac, 0x18, 0x46, 0x99, 0x40, 0xf7, 0xe1, 0xba, 0xf2, 0xd3, 0x28, 0x4d, 0x05,
+	0x2b, 0x4b, 0x1b, 0xb4, 0x41, 0x77, 0x8d, 0x34, 0x15, 0xb9, 0xaf, 0x39, 0xb4, 0x01, 0xeb, 0x29,
+	0x2b, 0x78, 0x6e, 0xaf, 0x28, 0x51, 0x03, 0x64, 0xc3, 0xeb, 0xac, 0x88, 0xe2, 0x77, 0x2c, 0xb5,
+	0xaf, 0xb5, 0x41, 0xb7, 0x41, 0xce, 0x21, 0x7a, 0x02, 0xcd, 0x84, 0x17, 0x52, 0x44, 0x89, 0xa4,
+	0xfc, 0x7d, 0xc1, 0x84, 0x5d, 0x6b, 0x83, 0xae, 0xb9, 0xb7, 0xe5, 0xfe, 0xbf, 0xa5, 0x1b, 0x56,
+	0x06, 0xb2, 0x7e, 0xfe, 0x40, 0x41, 0xf4, 0x02, 0xde, 0x4c, 0x78, 0x31, 0x67, 0xa2, 0xcc, 0x78,
+	0x41, 0x73, 0x9e, 0x32, 0xbb, 0xae, 0x22, 0x3a, 0x57, 0x45, 0xf4, 0x2e, 0xac, 0x03, 0x9e, 0x32,
+	0x62, 0x26, 0x97, 0xf0, 0xe3, 0xda, 0xaf, 0xaf, 0x77, 0x41, 0x27, 0x85, 0xab, 0xc3, 0x48, 0x44,
+	0x79, 0x89, 0xee, 0xc1, 0xa6, 0xde, 0x94, 0xaa, 0x04, 0x55, 0xb9, 0x41, 0x6e, 0x68, 0x0e, 0x57,
+	0x14, 0x7a, 0x04, 0xed, 0xe8, 0x44, 0x72, 0x2a, 0xd8, 0x34, 0x2b, 0x25, 0x13, 0x34, 0x8b, 0x13,
+	0xaa, 0x6a, 0x97, 0xea, 0x13, 0x1a, 0x64, 0xb3, 0xd2, 0xc9, 0x99, 0xec, 0xc7, 0x49, 0x5f, 0x89,
+	0xdb, 0xcf, 0x61, 0x5d, 0x37, 0xd8, 0x84, 0xb7, 0xc2, 0xa3, 0x00, 0x13, 0x3a, 0x09, 0x46, 0x43,
+	0xdc, 0xf3, 0x9f, 0xfa, 0xb8, 0x6f, 0x19, 0xc8, 0x82, 0x4d, 0x4d, 0x0f, 0xc2, 0xfe, 0xe4, 0x10,
+	0x5b, 0x00, 0x21, 0x68, 0x6a, 0x06, 0xbf, 0x1e, 0x63, 0x12, 0xec, 0x1f, 0x5a, 0x2b, 0xad, 0xda,
+	0xe7, 0x6f, 0x8e, 0xb1, 0xfd, 0x12, 0x9a, 0x97, 0x9b, 0xa1, 0x16, 0xbc, 0xdd, 0x0b, 0x83, 0x57,
+	0x98, 0x8c, 0xfc, 0x30, 0xa8, 0x22, 0x30, 0xc5, 0xa3, 0x1e, 0x09, 0x8f, 0x2c, 0x03, 0xdd, 0x81,
+	0x5b, 0xff, 0x6a, 0x03, 0x3f, 0x18, 0xd3, 0x83, 0x09, 0x09, 0x2c, 0xa0, 0x23, 0x0f, 0x9e, 0x7d,
+	0x5f, 0x38, 0xe0, 0x74, 0xe1, 0x80, 0x9f, 0x0b, 0x07, 0x7c, 0x59, 0x3a, 0xc6, 0xe9, 0xd2, 0x31,
+	0x7e, 0x2c, 0x1d, 0xe3, 0xcd, 0xce, 0x34, 0x93, 0xb3, 0x93, 0xd8, 0x4d, 0x78, 0xee, 0x7d, 0x62,
+	0x32, 0xda, 0x49, 0x66, 0x51, 0x56, 0x78, 0x7f, 0xcf, 0xee, 0xc3, 0xd9, 0xe1, 0xc9, 0x8f, 0xc7,
+	0xac, 0x8c, 0x57, 0xd5, 0x31, 0x3d, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0x73, 0xad, 0x5b, 0x11,
+	0x98, 0x02, 0x00, 0x00,
+}
+
+func (this *TokenPair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenPair)
+	if !ok {
+		that2, ok := that.(TokenPair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.ContractOwner != that1.ContractOwner {
+		return false
+	}
+	if this.ConversionMode != that1.ConversionMode {
+		return false
+	}
+	return true
+}
+func (m *TokenPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConversionMode != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.ConversionMode))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ContractOwner != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.ContractOwner))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoRegisterIbcDenoms {
+		i--
+		if m.AutoRegisterIbcDenoms {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EnableErc20 {
+		i--
+		if m.EnableErc20 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
+	offset -= sovErc20(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.ContractOwner != 0 {
+		n += 1 + sovErc20(uint64(m.ContractOwner))
+	}
+	if m.ConversionMode != 0 {
+		n += 1 + sovErc20(uint64(m.ConversionMode))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EnableErc20 {
+		n += 2
+	}
+	if m.AutoRegisterIbcDenoms {
+		n += 2
+	}
+	return n
+}
+
+func sovErc20(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozErc20(x uint64) (n int) {
+	return sovErc20(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			}
+			m.ContractOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractOwner |= Owner(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionMode", wireType)
+			}
+			m.ConversionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConversionMode |= ConversionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableErc20", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableErc20 = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRegisterIbcDenoms", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRegisterIbcDenoms = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipErc20(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthErc20
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupErc20
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthErc20
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthErc20        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowErc20          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupErc20 = fmt.Errorf("proto: unexpected end of group")
+)
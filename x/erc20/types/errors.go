@@ -0,0 +1,59 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	codeErrERC20Disabled = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrTokenPairNotFound
+	codeErrTokenPairAlreadyExists
+	codeErrTokenPairDisabled
+	codeErrMetadataNotFound
+	codeErrInvalidMetadata
+	codeErrEVMCall
+	codeErrBalanceInvariance
+)
+
+var (
+	// ErrERC20Disabled returns an error if the conversions are disabled by the module params.
+	ErrERC20Disabled = errorsmod.Register(ModuleName, codeErrERC20Disabled, "erc20 module is disabled")
+
+	// ErrTokenPairNotFound returns an error if the token pair isn't registered.
+	ErrTokenPairNotFound = errorsmod.Register(ModuleName, codeErrTokenPairNotFound, "token pair not found")
+
+	// ErrTokenPairAlreadyExists returns an error if the coin or the ERC-20 contract is already
+	// registered in a token pair.
+	ErrTokenPairAlreadyExists = errorsmod.Register(ModuleName, codeErrTokenPairAlreadyExists, "token pair already exists")
+
+	// ErrTokenPairDisabled returns an error if the conversion of the token pair is disabled.
+	ErrTokenPairDisabled = errorsmod.Register(ModuleName, codeErrTokenPairDisabled, "token pair conversion is disabled")
+
+	// ErrMetadataNotFound returns an error if the bank metadata of the coin isn't registered.
+	ErrMetadataNotFound = errorsmod.Register(ModuleName, codeErrMetadataNotFound, "coin metadata not found")
+
+	// ErrInvalidMetadata returns an error if the metadata of the coin or the ERC-20 contract
+	// can't be used to register a token pair.
+	ErrInvalidMetadata = errorsmod.Register(ModuleName, codeErrInvalidMetadata, "invalid token metadata")
+
+	// ErrEVMCall returns an error if a call to the ERC-20 contract fails.
+	ErrEVMCall = errorsmod.Register(ModuleName, codeErrEVMCall, "erc20 contract call failed")
+
+	// ErrBalanceInvariance returns an error if an ERC-20 transfer doesn't move the expected amount.
+	ErrBalanceInvariance = errorsmod.Register(ModuleName, codeErrBalanceInvariance, "unexpected balance change after the erc20 transfer")
+)
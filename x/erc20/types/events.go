@@ -0,0 +1,32 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package types
+
+// erc20 module events
+const (
+	EventTypeRegisterCoin     = "register_coin"
+	EventTypeRegisterERC20    = "register_erc20"
+	EventTypeToggleConversion = "toggle_token_conversion"
+	EventTypeConvertCoin      = "convert_coin"
+	EventTypeConvertERC20     = "convert_erc20"
+
+	AttributeKeyCosmosCoin     = "cosmos_coin"
+	AttributeKeyERC20Token     = "erc20_token"
+	AttributeKeyConversionMode = "conversion_mode"
+	AttributeKeyEnabled        = "enabled"
+	AttributeKeyReceiver       = "receiver"
+	AttributeKeyAmount         = "amount"
+)
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	contract := common.BigToAddress(big.NewInt(1))
+	other := common.BigToAddress(big.NewInt(2))
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{
+			"default",
+			DefaultGenesisState(),
+			true,
+		},
+		{
+			"valid genesis",
+			NewGenesisState(DefaultParams(), []TokenPair{
+				NewTokenPair(contract, "uatom", OWNER_MODULE, CONVERSION_MODE_ESCROW),
+				NewTokenPair(other, CreateDenom(other), OWNER_EXTERNAL, CONVERSION_MODE_ESCROW),
+			}),
+			true,
+		},
+		{
+			"mint and burn external contract",
+			NewGenesisState(DefaultParams(), []TokenPair{
+				NewTokenPair(other, CreateDenom(other), OWNER_EXTERNAL, CONVERSION_MODE_MINT_BURN),
+			}),
+			false,
+		},
+		{
+			"duplicated contract",
+			NewGenesisState(DefaultParams(), []TokenPair{
+				NewTokenPair(contract, "uatom", OWNER_MODULE, CONVERSION_MODE_ESCROW),
+				NewTokenPair(contract, "uosmo", OWNER_MODULE, CONVERSION_MODE_ESCROW),
+			}),
+			false,
+		},
+		{
+			"duplicated denom",
+			NewGenesisState(DefaultParams(), []TokenPair{
+				NewTokenPair(contract, "uatom", OWNER_MODULE, CONVERSION_MODE_ESCROW),
+				NewTokenPair(other, "uatom", OWNER_MODULE, CONVERSION_MODE_ESCROW),
+			}),
+			false,
+		},
+		{
+			"unspecified owner",
+			NewGenesisState(DefaultParams(), []TokenPair{
+				NewTokenPair(contract, "uatom", OWNER_UNSPECIFIED, CONVERSION_MODE_ESCROW),
+			}),
+			false,
+		},
+		{
+			"zero contract address",
+			NewGenesisState(DefaultParams(), []TokenPair{
+				NewTokenPair(common.Address{}, "uatom", OWNER_MODULE, CONVERSION_MODE_ESCROW),
+			}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.genState.Validate()
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
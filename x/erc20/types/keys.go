@@ -0,0 +1,76 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"strings"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// ModuleName string name of module
+	ModuleName = "erc20"
+
+	// StoreKey key for the token pairs and the params
+	StoreKey = ModuleName
+
+	// RouterKey uses module name for routing
+	RouterKey = ModuleName
+
+	// DenomPrefix is the prefix of the bank denoms created for the external ERC-20 contracts.
+	DenomPrefix = ModuleName + "/"
+)
+
+// ModuleAddress is the EVM address of the erc20 module account, the owner of the ERC-20
+// contracts deployed by the module.
+var ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName))
+
+// prefix bytes for the erc20 persistent store
+const (
+	prefixTokenPair = iota + 1
+	prefixTokenPairByDenom
+	prefixParams
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixTokenPair        = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+	KeyPrefixParams           = []byte{prefixParams}
+)
+
+// TokenPairKey returns the key of the token pair of the ERC-20 contract
+func TokenPairKey(contract common.Address) []byte {
+	return append(KeyPrefixTokenPair, contract.Bytes()...)
+}
+
+// TokenPairByDenomKey returns the key of the ERC-20 contract address of the denom
+func TokenPairByDenomKey(denom string) []byte {
+	return append(KeyPrefixTokenPairByDenom, []byte(denom)...)
+}
+
+// CreateDenom returns the bank denom of the external ERC-20 contract
+func CreateDenom(contract common.Address) string {
+	return DenomPrefix + contract.Hex()
+}
+
+// IsERC20Denom returns true if the denom is the bank representation of an external ERC-20
+// contract
+func IsERC20Denom(denom string) bool {
+	return strings.HasPrefix(denom, DenomPrefix) && common.IsHexAddress(strings.TrimPrefix(denom, DenomPrefix))
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+
+	authority string
+	address   common.Address
+	accAddr   string
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) SetupTest() {
+	suite.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	suite.address = common.HexToAddress("0x756F45E3FA69347A9A973A725E3C98bC4db0b5a0")
+	suite.accAddr = sdk.AccAddress(suite.address.Bytes()).String()
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertCoinValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgConvertCoin
+		expPass bool
+	}{
+		{"pass - valid msg", NewMsgConvertCoin(sdk.NewInt64Coin("uatom", 1), suite.address, suite.address.Bytes()), true},
+		{"fail - zero amount", NewMsgConvertCoin(sdk.NewInt64Coin("uatom", 0), suite.address, suite.address.Bytes()), false},
+		{"fail - invalid receiver", &MsgConvertCoin{Coin: sdk.NewInt64Coin("uatom", 1), Receiver: "invalid", Sender: suite.accAddr}, false},
+		{"fail - invalid sender", &MsgConvertCoin{Coin: sdk.NewInt64Coin("uatom", 1), Receiver: suite.address.Hex(), Sender: "invalid"}, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertERC20ValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgConvertERC20
+		expPass bool
+	}{
+		{"pass - valid msg", &MsgConvertERC20{suite.address.Hex(), sdkmath.NewInt(1), suite.accAddr, suite.accAddr}, true},
+		{"fail - zero contract", &MsgConvertERC20{common.Address{}.Hex(), sdkmath.NewInt(1), suite.accAddr, suite.accAddr}, false},
+		{"fail - zero amount", &MsgConvertERC20{suite.address.Hex(), sdkmath.ZeroInt(), suite.accAddr, suite.accAddr}, false},
+		{"fail - nil amount", &MsgConvertERC20{suite.address.Hex(), sdkmath.Int{}, suite.accAddr, suite.accAddr}, false},
+		{"fail - invalid receiver", &MsgConvertERC20{suite.address.Hex(), sdkmath.NewInt(1), suite.address.Hex(), suite.accAddr}, false},
+		{"fail - invalid sender", &MsgConvertERC20{suite.address.Hex(), sdkmath.NewInt(1), suite.accAddr, "invalid"}, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestGovMsgsValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     sdk.HasValidateBasic
+		expPass bool
+	}{
+		{"pass - register coin", &MsgRegisterCoin{suite.authority, "uatom", CONVERSION_MODE_MINT_BURN}, true},
+		{"fail - register coin invalid authority", &MsgRegisterCoin{"invalid", "uatom", CONVERSION_MODE_ESCROW}, false},
+		{"fail - register coin invalid denom", &MsgRegisterCoin{suite.authority, "1", CONVERSION_MODE_ESCROW}, false},
+		{"fail - register coin erc20 denom", &MsgRegisterCoin{suite.authority, CreateDenom(suite.address), CONVERSION_MODE_ESCROW}, false},
+		{"fail - register coin invalid mode", &MsgRegisterCoin{suite.authority, "uatom", ConversionMode(2)}, false},
+		{"pass - register erc20", &MsgRegisterERC20{suite.authority, suite.address.Hex(), CONVERSION_MODE_ESCROW}, true},
+		{"fail - register erc20 invalid address", &MsgRegisterERC20{suite.authority, "invalid", CONVERSION_MODE_ESCROW}, false},
+		{"fail - register erc20 mint and burn", &MsgRegisterERC20{suite.authority, suite.address.Hex(), CONVERSION_MODE_MINT_BURN}, false},
+		{"pass - toggle conversion denom", &MsgToggleConversion{suite.authority, "uatom"}, true},
+		{"pass - toggle conversion contract", &MsgToggleConversion{suite.authority, suite.address.Hex()}, true},
+		{"fail - toggle conversion invalid token", &MsgToggleConversion{suite.authority, "1"}, false},
+		{"pass - update params", &MsgUpdateParams{suite.authority, DefaultParams()}, true},
+		{"fail - update params invalid authority", &MsgUpdateParams{"invalid", DefaultParams()}, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package types
+
+var (
+	// DefaultEnableErc20 is true (i.e the registered token pairs can be converted)
+	DefaultEnableErc20 = true
+	// DefaultAutoRegisterIbcDenoms is false (i.e the IBC vouchers are registered by governance)
+	DefaultAutoRegisterIbcDenoms = false
+)
+
+// NewParams creates a new Params instance
+func NewParams(enableErc20, autoRegisterIbcDenoms bool) Params {
+	return Params{
+		EnableErc20:           enableErc20,
+		AutoRegisterIbcDenoms: autoRegisterIbcDenoms,
+	}
+}
+
+// DefaultParams returns default erc20 parameters
+func DefaultParams() Params {
+	return NewParams(DefaultEnableErc20, DefaultAutoRegisterIbcDenoms)
+}
+
+// Validate performs basic validation on erc20 parameters.
+func (p Params) Validate() error {
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	ethermint "github.com/zeta-chain/ethermint/types"
+)
+
+// NewTokenPair returns an enabled token pair of the ERC-20 contract and the bank denom.
+func NewTokenPair(contract common.Address, denom string, owner Owner, mode ConversionMode) TokenPair {
+	return TokenPair{
+		Erc20Address:   contract.Hex(),
+		Denom:          denom,
+		Enabled:        true,
+		ContractOwner:  owner,
+		ConversionMode: mode,
+	}
+}
+
+// GetERC20Contract returns the address of the ERC-20 contract of the token pair.
+func (tp TokenPair) GetERC20Contract() common.Address {
+	return common.HexToAddress(tp.Erc20Address)
+}
+
+// IsNativeCoin returns true if the bank coin is the original representation of the token.
+func (tp TokenPair) IsNativeCoin() bool {
+	return tp.ContractOwner == OWNER_MODULE
+}
+
+// IsNativeERC20 returns true if the ERC-20 contract is the original representation of the token.
+func (tp TokenPair) IsNativeERC20() bool {
+	return tp.ContractOwner == OWNER_EXTERNAL
+}
+
+// Validate performs a basic validation of the token pair fields.
+func (tp TokenPair) Validate() error {
+	if err := sdk.ValidateDenom(tp.Denom); err != nil {
+		return err
+	}
+
+	if err := ethermint.ValidateNonZeroAddress(tp.Erc20Address); err != nil {
+		return err
+	}
+
+	if _, ok := Owner_name[int32(tp.ContractOwner)]; !ok || tp.ContractOwner == OWNER_UNSPECIFIED {
+		return fmt.Errorf("invalid contract owner: %s", tp.ContractOwner)
+	}
+
+	if err := ValidateConversionMode(tp.ConversionMode); err != nil {
+		return err
+	}
+
+	if tp.IsNativeERC20() {
+		return ValidateExternalConversionMode(tp.ConversionMode)
+	}
+	return nil
+}
+
+// ValidateConversionMode returns an error if the conversion mode isn't defined.
+func ValidateConversionMode(mode ConversionMode) error {
+	if _, ok := ConversionMode_name[int32(mode)]; !ok {
+		return fmt.Errorf("invalid conversion mode: %d", mode)
+	}
+	return nil
+}
+
+// ValidateExternalConversionMode returns an error if the conversion mode can't be used for an
+// external ERC-20 contract. The module can't mint or burn the tokens of a contract it doesn't
+// own, so the external tokens are always escrowed.
+func ValidateExternalConversionMode(mode ConversionMode) error {
+	if mode != CONVERSION_MODE_ESCROW {
+		return fmt.Errorf("conversion mode %s is not supported for external contracts", mode)
+	}
+	return nil
+}
@@ -39,6 +39,8 @@ func GetQueryCmd() *cobra.Command {
 		GetStorageCmd(),
 		GetCodeCmd(),
 		GetParamsCmd(),
+		GetCreateAllowlistCmd(),
+		GetBlockedAddressesCmd(),
 	)
 	return cmd
 }
@@ -147,3 +149,59 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCreateAllowlistCmd queries the addresses allowed to deploy contracts
+func GetCreateAllowlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-allowlist",
+		Short: "Get the addresses allowed to deploy contracts",
+		Long:  "Get the addresses allowed to deploy contracts, an empty list allows every address.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CreateAllowlist(cmd.Context(), &types.QueryCreateAllowlistRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetBlockedAddressesCmd queries the addresses blocked in the EVM
+func GetBlockedAddressesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocked-addresses",
+		Short: "Get the addresses blocked in the EVM",
+		Long:  "Get the addresses that can't send or receive calls and value transfers in the EVM.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlockedAddresses(cmd.Context(), &types.QueryBlockedAddressesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
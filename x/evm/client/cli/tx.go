@@ -19,10 +19,13 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"strconv"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -31,6 +34,11 @@ import (
 	"github.com/zeta-chain/ethermint/x/evm/types"
 )
 
+const (
+	flagAdd    = "add"
+	flagRemove = "remove"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -40,7 +48,11 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand(NewRawTxCmd())
+	cmd.AddCommand(
+		NewRawTxCmd(),
+		NewUpdateCreateFactoriesCmd(),
+		NewApproveScheduledCallCmd(),
+	)
 	return cmd
 }
 
@@ -124,3 +136,83 @@ func NewRawTxCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewUpdateCreateFactoriesCmd command adds and removes the factory contracts allowed to
+// deploy contracts, it must be signed by the create factory admin.
+func NewUpdateCreateFactoriesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-create-factories",
+		Short: "Add and remove the factory contracts allowed to deploy contracts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			add, err := cmd.Flags().GetStringSlice(flagAdd)
+			if err != nil {
+				return err
+			}
+			remove, err := cmd.Flags().GetStringSlice(flagRemove)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateCreateFactories{
+				Admin:  clientCtx.GetFromAddress().String(),
+				Add:    add,
+				Remove: remove,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagAdd, nil, "hex addresses of the factory contracts to add")
+	cmd.Flags().StringSlice(flagRemove, nil, "hex addresses of the factory contracts to remove")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewApproveScheduledCallCmd command sets the amount of evm denom the payer of a scheduled
+// call allows to be charged for the executions, it must be signed by the payer.
+func NewApproveScheduledCallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-scheduled-call [id] [allowance]",
+		Short: "Set the amount the payer of a scheduled call allows to be charged for the executions",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid scheduled call id")
+			}
+			allowance, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid allowance %s", args[1])
+			}
+
+			msg := &types.MsgApproveScheduledCall{
+				Payer:     clientCtx.GetFromAddress().String(),
+				Id:        id,
+				Allowance: allowance,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
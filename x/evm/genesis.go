@@ -82,6 +82,19 @@ func InitGenesis(
 		}
 	}
 
+	nextCallID := k.GetNextScheduledCallID(ctx)
+	for _, call := range data.ScheduledCalls {
+		k.SetScheduledCall(ctx, call)
+		if call.Id >= nextCallID {
+			nextCallID = call.Id + 1
+		}
+	}
+	k.SetNextScheduledCallID(ctx, nextCallID)
+
+	for _, factory := range data.CreateFactories {
+		k.SetCreateFactory(ctx, common.HexToAddress(factory))
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -110,7 +123,9 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak types.AccountKeeper) *t
 	})
 
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:        ethGenAccounts,
+		Params:          k.GetParams(ctx),
+		ScheduledCalls:  k.GetScheduledCalls(ctx),
+		CreateFactories: k.GetCreateFactories(ctx),
 	}
 }
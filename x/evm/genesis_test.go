@@ -163,3 +163,19 @@ func (suite *EvmTestSuite) TestInitGenesis() {
 		})
 	}
 }
+
+func (suite *EvmTestSuite) TestDefaultGenesisActivePrecompiles() {
+	cdc := suite.app.AppCodec()
+	precompile := common.HexToAddress("0x0000000000000000000000000000000000000800")
+
+	var genesis types.GenesisState
+	cdc.MustUnmarshalJSON(evm.AppModuleBasic{}.DefaultGenesis(cdc), &genesis)
+	suite.Require().Empty(genesis.Params.ActivePrecompiles)
+
+	cdc.MustUnmarshalJSON(evm.NewAppModuleBasic(precompile).DefaultGenesis(cdc), &genesis)
+	suite.Require().Equal([]string{precompile.Hex()}, genesis.Params.ActivePrecompiles)
+	suite.Require().NoError(genesis.Validate())
+
+	// the app default genesis activates the precompiles registered by the app
+	suite.Require().Contains(suite.app.EvmKeeper.GetParams(suite.ctx).ActivePrecompiles, precompile.Hex())
+}
@@ -20,24 +20,41 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
-// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper.
+// StaleStoragePruneLimit is the maximum number of storage slots of the deleted accounts
+// garbage-collected at the end of each block.
+const StaleStoragePruneLimit = 1000
+
+// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper and records the
+// parent block hash in the block hash history.
 func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	k.WithChainID(ctx)
+
+	// like the EIP-2935 system call, the parent hash is recorded at the parent height,
+	// the first block of the chain has no parent
+	if parentHash := ctx.BlockHeader().LastBlockId.Hash; ctx.BlockHeight() > 0 && len(parentHash) != 0 {
+		// #nosec G115 block height always positive
+		k.SetBlockHash(ctx, uint64(ctx.BlockHeight()-1), common.BytesToHash(parentHash))
+	}
 	return nil
 }
 
-// EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
+// EndBlock executes the due scheduled calls, retrieves the bloom filter value from the transient
+// store and commits it to the KVStore, and garbage-collects the storage of the deleted accounts. The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	// Gas costs are handled within msg handler so costs should be ignored
 	infCtx := ctx.WithGasMeter(types.NewInfiniteGasMeter())
 
+	k.ExecuteScheduledCalls(infCtx)
+
 	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
 	k.EmitBlockBloomEvent(infCtx, bloom)
 
+	k.PruneStaleStorage(infCtx, StaleStoragePruneLimit)
+
 	return nil
 }
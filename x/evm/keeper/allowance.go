@@ -0,0 +1,44 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+// GetBankAllowance returns the allowance of the spender on the owner funds of the
+// given bank precompile.
+func (k Keeper) GetBankAllowance(ctx sdk.Context, contract, owner, spender common.Address) *big.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.BankAllowanceKey(contract, owner, spender))
+	return new(big.Int).SetBytes(bz)
+}
+
+// SetBankAllowance sets the allowance of the spender on the owner funds of the given
+// bank precompile, a zero allowance is deleted.
+func (k Keeper) SetBankAllowance(ctx sdk.Context, contract, owner, spender common.Address, value *big.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.BankAllowanceKey(contract, owner, spender)
+	if value.Sign() == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, value.Bytes())
+}
@@ -0,0 +1,91 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+// BlockHashReadGas is the gas charged to read a block hash from the history storage
+// contract, the cost of a cold storage read.
+const BlockHashReadGas = params.ColdSloadCostEIP2929
+
+// BlockHashStorageAddress is the address of the EIP-2935 history storage contract.
+var BlockHashStorageAddress = common.HexToAddress("0x0000F90827F1C53a10cb7A02335B175320002935")
+
+// SetBlockHash stores the hash of the given height in the block hash history ring
+// buffer, overriding the hash of the height BlockHashHistorySize blocks earlier.
+func (k Keeper) SetBlockHash(ctx sdk.Context, height uint64, hash common.Hash) {
+	ctx.KVStore(k.storeKey).Set(types.BlockHashKey(height), hash.Bytes())
+}
+
+// GetBlockHash returns the hash of the given height from the block hash history. It
+// returns false if the height is not one of the BlockHashHistorySize blocks preceding
+// the current block or if its hash has not been recorded, e.g. for the blocks prior to
+// the history activation.
+func (k Keeper) GetBlockHash(ctx sdk.Context, height uint64) (common.Hash, bool) {
+	// #nosec G115 block height always positive
+	current := uint64(ctx.BlockHeight())
+	if height >= current || current-height > types.BlockHashHistorySize {
+		return common.Hash{}, false
+	}
+
+	bz := ctx.KVStore(k.storeKey).Get(types.BlockHashKey(height))
+	if len(bz) == 0 {
+		return common.Hash{}, false
+	}
+	return common.BytesToHash(bz), true
+}
+
+var _ vm.StatefulPrecompiledContract = BlockHashContract{}
+
+// BlockHashContract serves the block hash history like the EIP-2935 history storage
+// contract: the input is the 32 bytes block number and the output its hash. The call
+// reverts for the current and future blocks and the blocks out of the history window.
+// It is available from the Prague fork time of the chain config.
+type BlockHashContract struct{}
+
+// Address implements vm.ContractRef
+func (BlockHashContract) Address() common.Address {
+	return BlockHashStorageAddress
+}
+
+// RequiredGas implements vm.StatefulPrecompiledContract
+func (BlockHashContract) RequiredGas([]byte) uint64 {
+	return BlockHashReadGas
+}
+
+// Run implements vm.StatefulPrecompiledContract
+func (BlockHashContract) Run(evm *vm.EVM, contract *vm.Contract, _ bool) ([]byte, error) {
+	if len(contract.Input) != common.HashLength {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	number := new(big.Int).SetBytes(contract.Input)
+	current := evm.Context.BlockNumber
+	if number.Cmp(current) >= 0 || new(big.Int).Sub(current, number).Cmp(big.NewInt(types.BlockHashHistorySize)) > 0 {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	return evm.Context.GetHash(number.Uint64()).Bytes(), nil
+}
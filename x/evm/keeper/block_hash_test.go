@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/zeta-chain/ethermint/server/config"
+	"github.com/zeta-chain/ethermint/x/evm/keeper"
+	"github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestBlockHashHistory() {
+	suite.SetupTest()
+
+	// the begin block records the parent block hash at the parent height
+	hash := common.BytesToHash(tmhash.Sum([]byte("block 10")))
+	suite.ctx = suite.withParentHash(11, hash)
+	suite.Require().NoError(suite.app.EvmKeeper.BeginBlock(suite.ctx))
+
+	recorded, found := suite.app.EvmKeeper.GetBlockHash(suite.ctx, 10)
+	suite.Require().True(found)
+	suite.Require().Equal(hash, recorded)
+
+	// the current block isn't part of the history
+	_, found = suite.app.EvmKeeper.GetBlockHash(suite.ctx, 11)
+	suite.Require().False(found)
+
+	// the hash is served for the BlockHashHistorySize blocks following it
+	suite.ctx = suite.ctx.WithBlockHeight(10 + types.BlockHashHistorySize)
+	recorded, found = suite.app.EvmKeeper.GetBlockHash(suite.ctx, 10)
+	suite.Require().True(found)
+	suite.Require().Equal(hash, recorded)
+	suite.Require().Equal(hash, suite.app.EvmKeeper.GetHashFn(suite.ctx)(10))
+
+	// the next block overrides its slot with the hash of its parent
+	newHash := common.BytesToHash(tmhash.Sum([]byte("block 8202")))
+	suite.ctx = suite.withParentHash(10+types.BlockHashHistorySize+1, newHash)
+	suite.Require().NoError(suite.app.EvmKeeper.BeginBlock(suite.ctx))
+
+	_, found = suite.app.EvmKeeper.GetBlockHash(suite.ctx, 10)
+	suite.Require().False(found)
+	recorded, found = suite.app.EvmKeeper.GetBlockHash(suite.ctx, 10+types.BlockHashHistorySize)
+	suite.Require().True(found)
+	suite.Require().Equal(newHash, recorded)
+
+	// unrecorded and future heights
+	_, found = suite.app.EvmKeeper.GetBlockHash(suite.ctx, 11)
+	suite.Require().False(found)
+	_, found = suite.app.EvmKeeper.GetBlockHash(suite.ctx, 11+types.BlockHashHistorySize)
+	suite.Require().False(found)
+}
+
+// withParentHash returns the context of the given height with the parent block hash
+// set in the header.
+func (suite *KeeperTestSuite) withParentHash(height int64, parentHash common.Hash) sdk.Context {
+	header := suite.ctx.BlockHeader()
+	header.Height = height
+	header.LastBlockId = tmproto.BlockID{Hash: parentHash.Bytes()}
+	return suite.ctx.WithBlockHeader(header)
+}
+
+func (suite *KeeperTestSuite) TestBlockHashContract() {
+	hash := common.BytesToHash(tmhash.Sum([]byte("block 5")))
+
+	testCases := []struct {
+		name    string
+		height  int64
+		prague  bool
+		input   []byte
+		expHash common.Hash
+		expPass bool
+	}{
+		{
+			"recorded block hash",
+			20,
+			true,
+			common.BigToHash(big.NewInt(5)).Bytes(),
+			hash,
+			true,
+		},
+		{
+			"unrecorded block hash",
+			20,
+			true,
+			common.BigToHash(big.NewInt(6)).Bytes(),
+			common.Hash{},
+			true,
+		},
+		{
+			"oldest block of the history window",
+			5 + types.BlockHashHistorySize,
+			true,
+			common.BigToHash(big.NewInt(5)).Bytes(),
+			hash,
+			true,
+		},
+		{
+			"block out of the history window",
+			5 + types.BlockHashHistorySize + 1,
+			true,
+			common.BigToHash(big.NewInt(5)).Bytes(),
+			common.Hash{},
+			false,
+		},
+		{
+			"current block",
+			20,
+			true,
+			common.BigToHash(big.NewInt(20)).Bytes(),
+			common.Hash{},
+			false,
+		},
+		{
+			"future block",
+			20,
+			true,
+			common.BigToHash(big.NewInt(21)).Bytes(),
+			common.Hash{},
+			false,
+		},
+		{
+			"invalid input length",
+			20,
+			true,
+			[]byte{5},
+			common.Hash{},
+			false,
+		},
+		{
+			"inactive before the prague fork",
+			20,
+			false,
+			common.BigToHash(big.NewInt(5)).Bytes(),
+			common.Hash{},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			if tc.prague {
+				params := suite.app.EvmKeeper.GetParams(suite.ctx)
+				forkTime := sdkmath.ZeroInt()
+				params.ChainConfig.ShanghaiTime = &forkTime
+				params.ChainConfig.CancunTime = &forkTime
+				params.ChainConfig.PragueTime = &forkTime
+				suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+			}
+			suite.app.EvmKeeper.SetBlockHash(suite.ctx, 5, hash)
+			suite.ctx = suite.ctx.WithBlockHeight(tc.height)
+
+			to := keeper.BlockHashStorageAddress
+			args, err := json.Marshal(&types.TransactionArgs{To: &to, Data: (*hexutil.Bytes)(&tc.input)})
+			suite.Require().NoError(err)
+
+			res, err := suite.app.EvmKeeper.EthCall(suite.ctx, &types.EthCallRequest{
+				Args:            args,
+				GasCap:          config.DefaultGasCap,
+				ProposerAddress: suite.ctx.BlockHeader().ProposerAddress,
+			})
+			suite.Require().NoError(err)
+			if !tc.expPass {
+				suite.Require().Equal(vm.ErrExecutionReverted.Error(), res.VmError)
+				return
+			}
+			suite.Require().Empty(res.VmError)
+			if !tc.prague {
+				// the address has no code before the fork
+				suite.Require().Empty(res.Ret)
+				return
+			}
+			suite.Require().Equal(tc.expHash.Bytes(), res.Ret)
+		})
+	}
+}
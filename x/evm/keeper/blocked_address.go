@@ -0,0 +1,87 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+
+	"github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+// aborter is implemented by the state db to fail the whole transaction from a call frame.
+type aborter interface {
+	Abort(err error)
+}
+
+var _ vm.StatefulPrecompiledContract = blockedAddressContract{}
+
+// blockedAddressContract is registered as a stateful precompiled contract at a blocked
+// address: the CALL, CALLCODE, DELEGATECALL and STATICCALL to the address run it instead
+// of the address code and fail, reverting the value transferred, and the whole
+// transaction is aborted.
+type blockedAddressContract struct {
+	address common.Address
+}
+
+// Address implements vm.ContractRef
+func (c blockedAddressContract) Address() common.Address {
+	return c.address
+}
+
+// RequiredGas implements vm.StatefulPrecompiledContract
+func (blockedAddressContract) RequiredGas([]byte) uint64 {
+	return 0
+}
+
+// Run implements vm.StatefulPrecompiledContract
+func (c blockedAddressContract) Run(evm *vm.EVM, _ *vm.Contract, _ bool) ([]byte, error) {
+	err := errorsmod.Wrapf(types.ErrBlockedAddress, "address %s", c.address.Hex())
+	if db, ok := evm.StateDB.(aborter); ok {
+		db.Abort(err)
+	}
+	return nil, err
+}
+
+// blockedBalance is the balance of a blocked address before the execution.
+type blockedBalance struct {
+	address common.Address
+	balance *uint256.Int
+}
+
+// blockedBalances returns the balances of the blocked addresses, the value transfers
+// that don't go through a call, e.g. to the beneficiary of a SELFDESTRUCT, are rejected
+// by checking them after the execution.
+func blockedBalances(stateDB vm.StateDB, params types.Params) []blockedBalance {
+	balances := make([]blockedBalance, len(params.BlockedAddresses))
+	for i, blocked := range params.BlockedAddresses {
+		addr := common.HexToAddress(blocked)
+		balances[i] = blockedBalance{address: addr, balance: stateDB.GetBalance(addr)}
+	}
+	return balances
+}
+
+// checkBlockedBalances returns an error if the balance of a blocked address increased.
+func checkBlockedBalances(stateDB vm.StateDB, balances []blockedBalance) error {
+	for _, b := range balances {
+		if stateDB.GetBalance(b.address).Gt(b.balance) {
+			return errorsmod.Wrapf(types.ErrBlockedAddress, "address %s", b.address.Hex())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+// CallEVM packs the method call with the contract ABI, executes it from the given address with
+// a gas limit of gasCap and unpacks the returned values. If commit is true, the state changes are
+// persisted and the nonce of the caller is increased, like for an Ethereum transaction.
+// A reverted call returns an ErrExecutionReverted error with the decoded revert reason.
+func (k *Keeper) CallEVM(
+	ctx sdk.Context,
+	from, contract common.Address,
+	contractABI abi.ABI,
+	method string,
+	gasCap uint64,
+	commit bool,
+	args ...interface{},
+) ([]interface{}, error) {
+	data, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to pack the %s method call", method)
+	}
+
+	res, err := k.CallEVMWithData(ctx, from, &contract, data, gasCap, commit)
+	if err != nil {
+		return nil, err
+	}
+	if res.Failed() {
+		return nil, revertError(&contractABI, res)
+	}
+
+	values, err := contractABI.Unpack(method, res.Ret)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to unpack the %s method result", method)
+	}
+	return values, nil
+}
+
+// DeployEVMContract deploys the contract bytecode with the ABI packed constructor arguments from the
+// given address and returns the address of the new contract. The state changes are persisted and
+// the nonce of the deployer is increased.
+func (k *Keeper) DeployEVMContract(
+	ctx sdk.Context,
+	from common.Address,
+	contractABI abi.ABI,
+	bytecode []byte,
+	gasCap uint64,
+	args ...interface{},
+) (common.Address, error) {
+	ctorArgs, err := contractABI.Pack("", args...)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrap(err, "failed to pack the constructor arguments")
+	}
+
+	data := make([]byte, 0, len(bytecode)+len(ctorArgs))
+	data = append(append(data, bytecode...), ctorArgs...)
+
+	nonce := k.GetNonce(ctx, from)
+	res, err := k.CallEVMWithData(ctx, from, nil, data, gasCap, true)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if res.Failed() {
+		return common.Address{}, revertError(&contractABI, res)
+	}
+
+	return crypto.CreateAddress(from, nonce), nil
+}
+
+// CallEVMWithData executes the raw call data against the contract, or deploys it as contract code
+// if the contract is nil, and returns the execution result without checking for a failure. If
+// commit is true, the state changes are persisted, the nonce of the caller is increased and the
+// execution is emitted as a `call_evm` event along with its logs.
+func (k *Keeper) CallEVMWithData(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	data []byte,
+	gasCap uint64,
+	commit bool,
+) (*types.MsgEthereumTxResponse, error) {
+	return k.callEVMWithValue(ctx, from, contract, big.NewInt(0), data, gasCap, commit)
+}
+
+// callEVMWithValue is CallEVMWithData transferring the given value from the caller to the
+// contract.
+func (k *Keeper) callEVMWithValue(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	value *big.Int,
+	data []byte,
+	gasCap uint64,
+	commit bool,
+) (*types.MsgEthereumTxResponse, error) {
+	nonce := k.GetNonce(ctx, from)
+
+	msg := &core.Message{
+		From:              from,
+		To:                contract,
+		Nonce:             nonce,
+		Value:             value,
+		GasLimit:          gasCap,
+		GasPrice:          big.NewInt(0),
+		GasFeeCap:         big.NewInt(0),
+		GasTipCap:         big.NewInt(0),
+		Data:              data,
+		AccessList:        ethtypes.AccessList{},
+		SkipAccountChecks: true,
+	}
+
+	res, err := k.ApplyMessage(ctx, msg, nil, commit)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to apply the evm call")
+	}
+
+	if !commit {
+		return res, nil
+	}
+
+	// the nonce of a contract creation is already increased by ApplyMessage
+	if contract != nil {
+		account := k.GetAccountOrEmpty(ctx, from)
+		account.Nonce = nonce + 1
+		if err := k.SetAccount(ctx, from, account); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to increase the caller nonce")
+		}
+	}
+
+	if err := k.emitCallEVMEvents(ctx, msg, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// emitCallEVMEvents emits the events of a committed evm call from a native module
+func (k *Keeper) emitCallEVMEvents(ctx sdk.Context, msg *core.Message, res *types.MsgEthereumTxResponse) error {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.From.Hex()),
+		sdk.NewAttribute(types.AttributeKeyTxNonce, strconv.FormatUint(msg.Nonce, 10)),
+		sdk.NewAttribute(types.AttributeKeyTxGasUsed, strconv.FormatUint(res.GasUsed, 10)),
+	}
+	if msg.To != nil {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyRecipient, msg.To.Hex()))
+	} else {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyContractAddress, crypto.CreateAddress(msg.From, msg.Nonce).Hex()))
+	}
+	if res.Failed() {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEthereumTxFailed, res.VmError))
+	}
+
+	for _, log := range res.Logs {
+		value, err := json.Marshal(log)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to encode log")
+		}
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyTxLog, string(value)))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeCallEVM, attrs...))
+	return nil
+}
+
+// revertError decodes the revert reason of a failed execution, either a revert string or a
+// custom error of the contract ABI.
+func revertError(contractABI *abi.ABI, res *types.MsgEthereumTxResponse) error {
+	if res.VmError != vm.ErrExecutionReverted.Error() {
+		return errorsmod.Wrap(types.ErrVMExecution, res.VmError)
+	}
+
+	if reason, err := abi.UnpackRevert(res.Ret); err == nil {
+		return errorsmod.Wrap(types.ErrExecutionReverted, reason)
+	}
+
+	if contractABI != nil && len(res.Ret) >= 4 {
+		if abiErr, err := contractABI.ErrorByID([4]byte(res.Ret[:4])); err == nil {
+			if values, err := abiErr.Unpack(res.Ret); err == nil {
+				return errorsmod.Wrapf(types.ErrExecutionReverted, "%s%v", abiErr.Name, values)
+			}
+		}
+	}
+
+	if len(res.Ret) > 0 {
+		return errorsmod.Wrap(types.ErrExecutionReverted, hexutil.Encode(res.Ret))
+	}
+	return types.ErrExecutionReverted
+}
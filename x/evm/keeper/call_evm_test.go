@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/zeta-chain/ethermint/server/config"
+	"github.com/zeta-chain/ethermint/tests"
+	"github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestCallEVM() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	erc20 := types.ERC20Contract
+	supply := big.NewInt(1000)
+	gasCap := uint64(config.DefaultGasCap)
+
+	nonce := k.GetNonce(suite.ctx, suite.address)
+	contract, err := k.DeployEVMContract(suite.ctx, suite.address, erc20.ABI, erc20.Bin, gasCap, suite.address, supply)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(k.GetCode(suite.ctx, common.BytesToHash(k.GetAccountOrEmpty(suite.ctx, contract).CodeHash)))
+	suite.Require().Equal(nonce+1, k.GetNonce(suite.ctx, suite.address))
+
+	// a query doesn't increase the nonce
+	values, err := k.CallEVM(suite.ctx, suite.address, contract, erc20.ABI, "balanceOf", gasCap, false, suite.address)
+	suite.Require().NoError(err)
+	suite.Require().Equal(supply, values[0])
+	suite.Require().Equal(nonce+1, k.GetNonce(suite.ctx, suite.address))
+
+	recipient := tests.GenerateAddress()
+	_, err = k.CallEVM(suite.ctx, suite.address, contract, erc20.ABI, "transfer", gasCap, true, recipient, big.NewInt(100))
+	suite.Require().NoError(err)
+	suite.Require().Equal(nonce+2, k.GetNonce(suite.ctx, suite.address))
+
+	values, err = k.CallEVM(suite.ctx, suite.address, contract, erc20.ABI, "balanceOf", gasCap, false, recipient)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(100), values[0])
+
+	var found bool
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeCallEVM {
+			continue
+		}
+		if recipientAttr, ok := event.GetAttribute(types.AttributeKeyRecipient); ok && recipientAttr.Value == contract.Hex() {
+			_, hasLog := event.GetAttribute(types.AttributeKeyTxLog)
+			suite.Require().True(hasLog)
+			found = true
+		}
+	}
+	suite.Require().True(found)
+
+	// the revert reason is decoded, the nonce is still increased
+	_, err = k.CallEVM(suite.ctx, suite.address, contract, erc20.ABI, "transfer", gasCap, true, recipient, big.NewInt(10000))
+	suite.Require().ErrorIs(err, types.ErrExecutionReverted)
+	suite.Require().Equal(nonce+3, k.GetNonce(suite.ctx, suite.address))
+
+	_, err = k.CallEVM(suite.ctx, suite.address, contract, erc20.ABI, "unknown", gasCap, false)
+	suite.Require().Error(err)
+
+	_, err = k.CallEVM(suite.ctx, suite.address, common.Address{}, erc20.ABI, "balanceOf", gasCap, false, suite.address)
+	suite.Require().Error(err)
+}
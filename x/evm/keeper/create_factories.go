@@ -0,0 +1,110 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+// IsCreateFactory returns true if the contract is a create factory managed by the
+// create factory admin.
+func (k Keeper) IsCreateFactory(ctx sdk.Context, address common.Address) bool {
+	return ctx.KVStore(k.storeKey).Has(types.CreateFactoryKey(address))
+}
+
+// SetCreateFactory marks the contract as a create factory.
+func (k Keeper) SetCreateFactory(ctx sdk.Context, address common.Address) {
+	ctx.KVStore(k.storeKey).Set(types.CreateFactoryKey(address), []byte{1})
+}
+
+// DeleteCreateFactory removes the contract from the create factories.
+func (k Keeper) DeleteCreateFactory(ctx sdk.Context, address common.Address) {
+	ctx.KVStore(k.storeKey).Delete(types.CreateFactoryKey(address))
+}
+
+// GetCreateFactories returns the hex addresses of the create factories.
+func (k Keeper) GetCreateFactories(ctx sdk.Context) []string {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixCreateFactory)
+	defer iterator.Close()
+
+	factories := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		address := common.BytesToAddress(iterator.Key()[len(types.KeyPrefixCreateFactory):])
+		factories = append(factories, address.Hex())
+	}
+	return factories
+}
+
+// isCreateAllowed returns true if the address is allowed to deploy contracts, either
+// in the create allowlist of the params or as a create factory.
+func (k Keeper) isCreateAllowed(ctx sdk.Context, params types.Params, address common.Address) bool {
+	return params.IsCreateAllowed(address) || k.IsCreateFactory(ctx, address)
+}
+
+// callGuard wraps the EVM tracer to record the first contract creation breaking the
+// create allowlist, go-ethereum has no hook to reject the nested contract creations. The
+// execution is cancelled as soon as the interpreter checks it and the whole transaction
+// is reverted afterwards.
+type callGuard struct {
+	vm.EVMLogger
+
+	// isCreateAllowed returns true if the address can deploy contracts, either from the
+	// create allowlist or as a create factory
+	isCreateAllowed func(common.Address) bool
+	evm             *vm.EVM
+	// err is the error of the first call frame rejected, if any
+	err error
+}
+
+var _ vm.EVMLogger = (*callGuard)(nil)
+
+func newCallGuard(tracer vm.EVMLogger, isCreateAllowed func(common.Address) bool) *callGuard {
+	if tracer == nil {
+		tracer = types.NewNoOpTracer()
+	}
+	return &callGuard{EVMLogger: tracer, isCreateAllowed: isCreateAllowed}
+}
+
+// CaptureStart implements vm.EVMLogger
+func (g *callGuard) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	g.evm = env
+	g.EVMLogger.CaptureStart(env, from, to, create, input, gas, value)
+}
+
+// CaptureEnter implements vm.EVMLogger
+func (g *callGuard) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	create := typ == vm.CREATE || typ == vm.CREATE2
+	if g.err == nil && create && !g.isCreateAllowed(from) {
+		g.err = errorsmod.Wrapf(types.ErrCreateNotAllowed, "address %s", from.Hex())
+		if g.evm != nil {
+			g.evm.Cancel()
+		}
+	}
+	g.EVMLogger.CaptureEnter(typ, from, to, input, gas, value)
+}
+
+// Err returns the error of the first call frame rejected, if any.
+func (g *callGuard) Err() error {
+	return g.err
+}
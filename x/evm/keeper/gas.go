@@ -27,6 +27,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	ethermint "github.com/zeta-chain/ethermint/types"
 	"github.com/zeta-chain/ethermint/x/evm/types"
 )
 
@@ -35,8 +36,9 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg *core.Message, cfg *par
 	height := big.NewInt(ctx.BlockHeight())
 	homestead := cfg.IsHomestead(height)
 	istanbul := cfg.IsIstanbul(height)
+	shanghai := cfg.IsShanghai(height, ethermint.BlockTime(ctx))
 
-	return core.IntrinsicGas(msg.Data, msg.AccessList, isContractCreation, homestead, istanbul, false)
+	return core.IntrinsicGas(msg.Data, msg.AccessList, isContractCreation, homestead, istanbul, shanghai)
 }
 
 // RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
@@ -69,6 +71,59 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg *core.Message, leftoverGas uint6
 	return nil
 }
 
+// RefundGasInFeeDenom refunds the leftover gas of a message whose fee has been paid in an
+// alternative fee denom. The refund is proportional to the fee paid, so it doesn't depend on
+// the conversion rate changing during the transaction.
+func (k *Keeper) RefundGasInFeeDenom(ctx sdk.Context, msg *core.Message, leftoverGas uint64, fee sdk.Coin) error {
+	if msg.GasLimit == 0 || leftoverGas == 0 {
+		return nil
+	}
+
+	remaining := fee.Amount.Mul(sdkmath.NewIntFromUint64(leftoverGas)).Quo(sdkmath.NewIntFromUint64(msg.GasLimit))
+	if !remaining.IsPositive() {
+		return nil
+	}
+
+	refundedCoins := sdk.Coins{sdk.NewCoin(fee.Denom, remaining)}
+
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From.Bytes(), refundedCoins)
+	if err != nil {
+		err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
+		return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
+	}
+
+	return nil
+}
+
+// BurnBaseFee burns the base fee part of the fees paid for the gas used by the message, only
+// the priority tip is left in the fee collector. The burned amount is added to the block total
+// tracked by the fee market module.
+func (k *Keeper) BurnBaseFee(ctx sdk.Context, msg *core.Message, gasUsed uint64, baseFee *big.Int, denom string) error {
+	// the effective gas price is never lower than the base fee for a valid tx, cap it anyway
+	price := baseFee
+	if msg.GasPrice.Cmp(price) < 0 {
+		price = msg.GasPrice
+	}
+
+	burned := new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), price)
+	if burned.Sign() <= 0 {
+		return nil
+	}
+
+	burnedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(burned))}
+
+	// the fee collector module account doesn't have the burner permission
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, burnedCoins); err != nil {
+		return errorsmod.Wrapf(err, "failed to send the base fee %s to the evm module", burnedCoins.String())
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnedCoins); err != nil {
+		return errorsmod.Wrapf(err, "failed to burn the base fee %s", burnedCoins.String())
+	}
+
+	k.feeMarketKeeper.AddTransientBurnedBaseFee(ctx, burned)
+	return nil
+}
+
 // ResetGasMeterAndConsumeGas reset first the gas meter consumed value to zero and set it back to the new value
 // 'gasUsed'
 func (k *Keeper) ResetGasMeterAndConsumeGas(ctx sdk.Context, gasUsed uint64) {
@@ -31,7 +31,9 @@ import (
 	"google.golang.org/grpc/status"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -249,9 +251,15 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if err := setCallOverrides(cfg, req.Overrides, req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
+	if override, ok := cfg.StateOverrides[args.GetFrom()]; ok && override.Nonce != nil {
+		nonce = uint64(*override.Nonce)
+	}
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
@@ -304,10 +312,15 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		hi = uint64(*args.Gas)
 	} else {
 		// Query block gas limit
+		var blockGasLimit uint64
 		params := ctx.ConsensusParams()
 		if params.Block != nil && params.Block.MaxGas > 0 {
 			// #nosec G115 always in range
-			hi = uint64(params.Block.MaxGas)
+			blockGasLimit = uint64(params.Block.MaxGas)
+		}
+		// the EVM transactions are limited by the EVM block gas budget
+		if blockGasLimit = k.GetParams(ctx).EVMBlockGasLimit(blockGasLimit); blockGasLimit > 0 {
+			hi = blockGasLimit
 		} else {
 			hi = req.GasCap
 		}
@@ -324,9 +337,15 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
+	if err := setCallOverrides(cfg, req.Overrides, req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
+	if override, ok := cfg.StateOverrides[args.GetFrom()]; ok && override.Nonce != nil {
+		nonce = uint64(*override.Nonce)
+	}
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
@@ -421,7 +440,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 
 		var msg *core.Message
 		if !isUnsigned(ethTx) {
-			signer := ethermint.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+			signer := ethermint.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()), ethermint.BlockTime(ctx))
 			msg, err = core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
 			if err != nil {
 				return nil, fmt.Errorf("transaction to message: %w", err)
@@ -541,6 +560,82 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	}, nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call on top of the state of the requested block. The return
+// value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	contextHeight := req.BlockNumber
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = ctx.WithBlockHeight(contextHeight)
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+	if err := setCallOverrides(cfg, req.Overrides, req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	if override, ok := cfg.StateOverrides[args.GetFrom()]; ok && override.Nonce != nil {
+		nonce = uint64(*override.Nonce)
+	}
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	// pass false to not commit StateDB
+	result, _, err := k.traceMsg(ctx, cfg, txConfig, msg, req.TraceConfig, false, tracerConfig)
+	if err != nil {
+		// error will be returned with detail status from traceMsg
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
 // traceTx do trace on one transaction, it returns a tuple: (traceResult, nextLogIndex, error).
 func (k *Keeper) traceTx(
 	ctx sdk.Context,
@@ -552,15 +647,11 @@ func (k *Keeper) traceTx(
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
 ) (*interface{}, uint, error) {
-	// Assemble the structured logger or the JavaScript tracer
 	var (
-		tracer    tracers.Tracer
-		overrides *ethparams.ChainConfig
-		err       error
-		timeout   = defaultTraceTimeout
+		msg *core.Message
+		err error
 	)
-	signer := ethermint.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
-	var msg *core.Message
+	signer := ethermint.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()), ethermint.BlockTime(ctx))
 	if !isUnsigned(tx) {
 		msg, err = core.TransactionToMessage(tx, signer, cfg.BaseFee)
 		if err != nil {
@@ -570,6 +661,27 @@ func (k *Keeper) traceTx(
 		msg = unsignedTxAsMessage(from, tx, cfg.BaseFee)
 	}
 
+	return k.traceMsg(ctx, cfg, txConfig, msg, traceConfig, commitMessage, tracerJSONConfig)
+}
+
+// traceMsg do trace on one message, it returns a tuple: (traceResult, nextLogIndex, error).
+func (k *Keeper) traceMsg(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg *core.Message,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
+	tracerJSONConfig json.RawMessage,
+) (*interface{}, uint, error) {
+	// Assemble the structured logger or the JavaScript tracer
+	var (
+		tracer    tracers.Tracer
+		overrides *ethparams.ChainConfig
+		err       error
+		timeout   = defaultTraceTimeout
+	)
+
 	if traceConfig == nil {
 		traceConfig = &types.TraceConfig{}
 	}
@@ -652,6 +764,79 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 	return res, nil
 }
 
+// CreateAllowlist implements the Query/CreateAllowlist gRPC method
+func (k Keeper) CreateAllowlist(c context.Context, _ *types.QueryCreateAllowlistRequest) (*types.QueryCreateAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryCreateAllowlistResponse{
+		Addresses: params.CreateAllowlist,
+		Factories: k.GetCreateFactories(ctx),
+	}, nil
+}
+
+// BlockedAddresses implements the Query/BlockedAddresses gRPC method
+func (k Keeper) BlockedAddresses(c context.Context, _ *types.QueryBlockedAddressesRequest) (*types.QueryBlockedAddressesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryBlockedAddressesResponse{Addresses: params.BlockedAddresses}, nil
+}
+
+// ScheduledCalls implements the Query/ScheduledCalls gRPC method
+func (k Keeper) ScheduledCalls(c context.Context, req *types.QueryScheduledCallsRequest) (*types.QueryScheduledCallsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduledCall)
+
+	calls := []types.ScheduledCall{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var call types.ScheduledCall
+		if err := k.cdc.Unmarshal(value, &call); err != nil {
+			return err
+		}
+		calls = append(calls, call)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduledCallsResponse{
+		Calls:      calls,
+		Pagination: pageRes,
+	}, nil
+}
+
+// setCallOverrides decodes the optional state and block overrides of the request
+// and sets them on the EVM config.
+func setCallOverrides(cfg *statedb.EVMConfig, overrides, blockOverridesJSON []byte) error {
+	if len(overrides) > 0 {
+		if err := json.Unmarshal(overrides, &cfg.StateOverrides); err != nil {
+			return fmt.Errorf("invalid state overrides: %w", err)
+		}
+	}
+
+	if len(blockOverridesJSON) > 0 {
+		var blockOverrides types.BlockOverrides
+		if err := json.Unmarshal(blockOverridesJSON, &blockOverrides); err != nil {
+			return fmt.Errorf("invalid block overrides: %w", err)
+		}
+		cfg.BlockOverrides = &blockOverrides
+		// the coinbase and base fee are also used outside of the EVM block context
+		if blockOverrides.Coinbase != nil {
+			cfg.CoinBase = *blockOverrides.Coinbase
+		}
+		if blockOverrides.BaseFee != nil {
+			cfg.BaseFee = blockOverrides.BaseFee.ToInt()
+		}
+	}
+	return nil
+}
+
 // getChainID parse chainID from current context if not provided
 func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	if chainID == 0 {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -403,13 +404,49 @@ func (suite *KeeperTestSuite) TestQueryTxLogs() {
 
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := sdk.WrapSDKContext(suite.ctx)
-	expParams := types.DefaultParams()
+	expParams := suite.DefaultParams()
 
 	res, err := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().NoError(err)
 	suite.Require().Equal(expParams, res.Params)
 }
 
+func (suite *KeeperTestSuite) TestQueryCreateAllowlist() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.queryClient.CreateAllowlist(ctx, &types.QueryCreateAllowlistRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Addresses)
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.CreateAllowlist = []string{suite.address.Hex()}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	factory := common.BigToAddress(big.NewInt(0x1234))
+	suite.app.EvmKeeper.SetCreateFactory(suite.ctx, factory)
+
+	res, err = suite.queryClient.CreateAllowlist(ctx, &types.QueryCreateAllowlistRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{suite.address.Hex()}, res.Addresses)
+	suite.Require().Equal([]string{factory.Hex()}, res.Factories)
+}
+
+func (suite *KeeperTestSuite) TestQueryBlockedAddresses() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.queryClient.BlockedAddresses(ctx, &types.QueryBlockedAddressesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Addresses)
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.BlockedAddresses = []string{suite.address.Hex()}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	res, err = suite.queryClient.BlockedAddresses(ctx, &types.QueryBlockedAddressesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{suite.address.Hex()}, res.Addresses)
+}
+
 func (suite *KeeperTestSuite) TestQueryValidatorAccount() {
 	var (
 		req        *types.QueryValidatorAccountRequest
@@ -1334,6 +1371,193 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthCallOverrides() {
+	// returns the value of storage slot 0
+	sloadCode := hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+	// returns the current block number
+	numberCode := hexutil.Bytes(common.FromHex("0x4360005260206000f3"))
+
+	from := tests.GenerateAddress()
+	contract := tests.GenerateAddress()
+	slot := common.Hash{}
+	value := common.BigToHash(big.NewInt(42))
+
+	testCases := []struct {
+		name           string
+		overrides      types.StateOverride
+		blockOverrides *types.BlockOverrides
+		expRet         common.Hash
+		expPass        bool
+	}{
+		{
+			"no overrides - empty code",
+			nil,
+			nil,
+			common.Hash{},
+			true,
+		},
+		{
+			"override code and state",
+			types.StateOverride{
+				contract: {
+					Code:  &sloadCode,
+					State: &map[common.Hash]common.Hash{slot: value},
+				},
+			},
+			nil,
+			value,
+			true,
+		},
+		{
+			"override code and state diff",
+			types.StateOverride{
+				contract: {
+					Code:      &sloadCode,
+					StateDiff: &map[common.Hash]common.Hash{slot: value},
+				},
+			},
+			nil,
+			value,
+			true,
+		},
+		{
+			"fail - both state and state diff",
+			types.StateOverride{
+				contract: {
+					Code:      &sloadCode,
+					State:     &map[common.Hash]common.Hash{slot: value},
+					StateDiff: &map[common.Hash]common.Hash{slot: value},
+				},
+			},
+			nil,
+			common.Hash{},
+			false,
+		},
+		{
+			"override block number",
+			types.StateOverride{
+				contract: {Code: &numberCode},
+			},
+			&types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1000))},
+			common.BigToHash(big.NewInt(1000)),
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			args, err := json.Marshal(&types.TransactionArgs{From: &from, To: &contract})
+			suite.Require().NoError(err)
+			req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap}
+			if tc.overrides != nil {
+				req.Overrides, err = json.Marshal(tc.overrides)
+				suite.Require().NoError(err)
+			}
+			if tc.blockOverrides != nil {
+				req.BlockOverrides, err = json.Marshal(tc.blockOverrides)
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.queryClient.EthCall(suite.ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Empty(res.VmError)
+				suite.Require().Equal(tc.expRet, common.BytesToHash(res.Ret))
+			} else {
+				suite.Require().Error(err)
+			}
+
+			// the overrides must not be persisted
+			suite.Require().Empty(suite.app.EvmKeeper.GetCode(suite.ctx, crypto.Keccak256Hash(sloadCode)))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTraceCall() {
+	// returns the value of storage slot 0
+	sloadCode := hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+
+	from := tests.GenerateAddress()
+	contract := tests.GenerateAddress()
+	value := common.BigToHash(big.NewInt(42))
+	overrides := types.StateOverride{
+		contract: {
+			Code:  &sloadCode,
+			State: &map[common.Hash]common.Hash{{}: value},
+		},
+	}
+
+	testCases := []struct {
+		msg           string
+		traceConfig   *types.TraceConfig
+		overrides     types.StateOverride
+		expPass       bool
+		traceResponse string
+	}{
+		{
+			msg:           "default trace",
+			traceConfig:   nil,
+			overrides:     overrides,
+			expPass:       true,
+			traceResponse: "{\"gas\":12500000,\"failed\":false,\"returnValue\":\"000000000000000000000000000000000000000000000000000000000000002a\",\"structLogs\":[{\"pc\":0,\"op\":\"PUSH1\",\"gas\":",
+		},
+		{
+			msg:           "default trace without overrides",
+			traceConfig:   &types.TraceConfig{DisableStack: true, DisableStorage: true},
+			overrides:     nil,
+			expPass:       true,
+			traceResponse: "{\"gas\":12500000,\"failed\":false,\"returnValue\":\"\",\"structLogs\":[]}",
+		},
+		{
+			msg:           "call tracer",
+			traceConfig:   &types.TraceConfig{Tracer: "callTracer"},
+			overrides:     overrides,
+			expPass:       true,
+			traceResponse: "{\"from\":\"" + strings.ToLower(from.Hex()),
+		},
+		{
+			msg:         "invalid trace config - negative limit",
+			traceConfig: &types.TraceConfig{Limit: -1},
+			expPass:     false,
+		},
+		{
+			msg:         "invalid trace config - invalid tracer",
+			traceConfig: &types.TraceConfig{Tracer: "invalid_tracer"},
+			expPass:     false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+
+			args, err := json.Marshal(&types.TransactionArgs{From: &from, To: &contract})
+			suite.Require().NoError(err)
+			req := &types.QueryTraceCallRequest{
+				Args:        args,
+				GasCap:      config.DefaultGasCap,
+				TraceConfig: tc.traceConfig,
+				BlockNumber: suite.ctx.BlockHeight(),
+			}
+			if tc.overrides != nil {
+				req.Overrides, err = json.Marshal(tc.overrides)
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.queryClient.TraceCall(suite.ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(strings.HasPrefix(string(res.Data), tc.traceResponse), string(res.Data))
+			} else {
+				suite.Require().Error(err)
+			}
+
+			// the call must not be persisted
+			suite.Require().Empty(suite.app.EvmKeeper.GetCode(suite.ctx, crypto.Keccak256Hash(sloadCode)))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	k := suite.app.EvmKeeper
 
@@ -23,7 +23,12 @@ import (
 	"github.com/zeta-chain/ethermint/x/evm/types"
 )
 
-var _ types.EvmHooks = MultiEvmHooks{}
+var (
+	_ types.EvmHooks      = MultiEvmHooks{}
+	_ types.EvmPreTxHooks = MultiEvmHooks{}
+	_ types.EvmHooks      = FilteredEvmHooks{}
+	_ types.EvmPreTxHooks = FilteredEvmHooks{}
+)
 
 // MultiEvmHooks combine multiple evm hooks, all hook functions are run in array sequence
 type MultiEvmHooks []types.EvmHooks
@@ -42,3 +47,51 @@ func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, msg *core.Message, rec
 	}
 	return nil
 }
+
+// PreTxProcessing delegate the call to the underlying hooks implementing `types.EvmPreTxHooks`
+func (mh MultiEvmHooks) PreTxProcessing(ctx sdk.Context, msg *core.Message) error {
+	for i := range mh {
+		preTxHooks, ok := mh[i].(types.EvmPreTxHooks)
+		if !ok {
+			continue
+		}
+		if err := preTxHooks.PreTxProcessing(ctx, msg); err != nil {
+			return errorsmod.Wrapf(err, "EVM pre tx hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
+
+// FilteredEvmHooks only dispatches to the underlying hooks the receipts with at least one log
+// matching one of the filters
+type FilteredEvmHooks struct {
+	hooks   types.EvmHooks
+	filters []types.LogFilter
+}
+
+// NewFilteredEvmHooks registers the log filters of the evm hooks
+func NewFilteredEvmHooks(hooks types.EvmHooks, filters ...types.LogFilter) FilteredEvmHooks {
+	return FilteredEvmHooks{
+		hooks:   hooks,
+		filters: filters,
+	}
+}
+
+// PreTxProcessing delegate the call to the underlying hooks if they implement `types.EvmPreTxHooks`
+func (fh FilteredEvmHooks) PreTxProcessing(ctx sdk.Context, msg *core.Message) error {
+	preTxHooks, ok := fh.hooks.(types.EvmPreTxHooks)
+	if !ok {
+		return nil
+	}
+	return preTxHooks.PreTxProcessing(ctx, msg)
+}
+
+// PostTxProcessing delegate the call to the underlying hooks if the receipt has a matching log
+func (fh FilteredEvmHooks) PostTxProcessing(ctx sdk.Context, msg *core.Message, receipt *ethtypes.Receipt) error {
+	for _, filter := range fh.filters {
+		if filter.MatchAny(receipt.Logs) {
+			return fh.hooks.PostTxProcessing(ctx, msg, receipt)
+		}
+	}
+	return nil
+}
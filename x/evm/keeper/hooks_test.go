@@ -31,6 +31,26 @@ func (dh FailureHook) PostTxProcessing(ctx sdk.Context, msg *core.Message, recei
 	return errors.New("post tx processing failed")
 }
 
+// GasPriceHook overrides the gas price of the message
+type GasPriceHook struct {
+	LogRecordHook
+	GasPrice *big.Int
+}
+
+func (dh *GasPriceHook) PreTxProcessing(ctx sdk.Context, msg *core.Message) error {
+	msg.GasPrice = dh.GasPrice
+	return nil
+}
+
+// RejectHook always rejects the message
+type RejectHook struct {
+	LogRecordHook
+}
+
+func (dh *RejectHook) PreTxProcessing(ctx sdk.Context, msg *core.Message) error {
+	return errors.New("pre tx processing failed")
+}
+
 func (suite *KeeperTestSuite) TestEvmHooks() {
 	testCases := []struct {
 		msg       string
@@ -87,3 +107,75 @@ func (suite *KeeperTestSuite) TestEvmHooks() {
 		tc.expFunc(hook, result)
 	}
 }
+
+func (suite *KeeperTestSuite) TestEvmPreTxHooks() {
+	suite.SetupTest()
+	gasPriceHook := &GasPriceHook{GasPrice: big.NewInt(42)}
+	suite.app.EvmKeeper.SetHooks(keeper.NewMultiEvmHooks(&LogRecordHook{}, gasPriceHook))
+
+	msg := &core.Message{GasPrice: big.NewInt(1)}
+	suite.Require().NoError(suite.app.EvmKeeper.PreTxProcessing(suite.ctx, msg))
+	suite.Require().Equal(big.NewInt(42), msg.GasPrice)
+
+	suite.SetupTest()
+	suite.app.EvmKeeper.SetHooks(keeper.NewMultiEvmHooks(gasPriceHook, &RejectHook{}))
+	suite.Require().Error(suite.app.EvmKeeper.PreTxProcessing(suite.ctx, msg))
+
+	// the rejected tx is not executed
+	suite.SetupTest()
+	suite.app.EvmKeeper.SetHooks(keeper.NewMultiEvmHooks(keeper.NewFilteredEvmHooks(&RejectHook{})))
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	tx := types.NewTx(suite.app.EvmKeeper.ChainID(), nonce, &common.Address{}, nil, 21000, big.NewInt(1), nil, nil, nil, nil)
+	tx.From = suite.address.Hex()
+	suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID()), suite.signer))
+
+	_, err := suite.app.EvmKeeper.ApplyTransaction(suite.ctx, tx)
+	suite.Require().ErrorContains(err, "pre tx processing failed")
+}
+
+func (suite *KeeperTestSuite) TestFilteredEvmHooks() {
+	topic := common.BytesToHash([]byte("topic"))
+	testCases := []struct {
+		name     string
+		filters  []types.LogFilter
+		expCalls bool
+	}{
+		{
+			"no filter",
+			nil,
+			false,
+		},
+		{
+			"matching address",
+			[]types.LogFilter{types.NewLogFilter([]common.Address{suite.address})},
+			true,
+		},
+		{
+			"matching topic",
+			[]types.LogFilter{
+				types.NewLogFilter([]common.Address{common.HexToAddress("0x1")}),
+				types.NewLogFilter(nil, []common.Hash{topic}),
+			},
+			true,
+		},
+		{
+			"no matching log",
+			[]types.LogFilter{types.NewLogFilter([]common.Address{suite.address}, []common.Hash{common.HexToHash("0x1")})},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			hook := &LogRecordHook{}
+			suite.app.EvmKeeper.SetHooks(keeper.NewFilteredEvmHooks(hook, tc.filters...))
+
+			receipt := &ethtypes.Receipt{
+				Logs: []*ethtypes.Log{{Address: suite.address, Topics: []common.Hash{topic}}},
+			}
+			suite.Require().NoError(suite.app.EvmKeeper.PostTxProcessing(suite.ctx, &core.Message{}, receipt))
+			suite.Require().Equal(tc.expCalls, hook.Logs != nil)
+		})
+	}
+}
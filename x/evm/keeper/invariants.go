@@ -0,0 +1,221 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	ethermint "github.com/zeta-chain/ethermint/types"
+	"github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+// storageKeyLength is the length of a storage key without its prefix: the account
+// address, the incarnation and the slot.
+const storageKeyLength = common.AddressLength + 8 + common.HashLength
+
+// RegisterInvariants registers the evm module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "account-code", AccountCodeInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "contract-storage", ContractStorageInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-account-balance", ModuleAccountBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "transient-counters", TransientCountersInvariant(k))
+}
+
+// AllInvariants runs all invariants of the evm module.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			AccountCodeInvariant(k),
+			ContractStorageInvariant(k),
+			ModuleAccountBalanceInvariant(k),
+			TransientCountersInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// AccountCodeInvariant checks that the code hash of every Ethereum account refers to a
+// code stored by the module.
+func AccountCodeInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		emptyCodeHash := crypto.Keccak256Hash(nil)
+		k.accountKeeper.IterateAccounts(ctx, func(account sdk.AccountI) bool {
+			ethAccount, ok := account.(ethermint.EthAccountI)
+			if !ok {
+				return false
+			}
+
+			codeHash := ethAccount.GetCodeHash()
+			if codeHash == emptyCodeHash || codeHash == (common.Hash{}) {
+				return false
+			}
+
+			if len(k.GetCode(ctx, codeHash)) == 0 {
+				count++
+				msg += fmt.Sprintf("\taccount %s has no code for the code hash %s\n", ethAccount.EthAddress(), codeHash)
+			}
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "account-code",
+			fmt.Sprintf("accounts with a missing code found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// ContractStorageInvariant checks that the storage of the current incarnation of an
+// account only exists for contracts, and that the storage of the previous incarnations
+// is marked as stale, i.e. pending for garbage collection.
+func ContractStorageInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		store := ctx.KVStore(k.storeKey)
+		storageStore := prefix.NewStore(store, types.KeyPrefixStorage)
+
+		iterator := storageStore.Iterator(nil, nil)
+		defer iterator.Close()
+
+		// the storage keys are grouped by address and incarnation, only the first slot of
+		// each group is checked
+		var lastGroup []byte
+		for ; iterator.Valid(); iterator.Next() {
+			key := iterator.Key()
+			if len(key) != storageKeyLength {
+				count++
+				msg += fmt.Sprintf("\tinvalid storage key %X\n", key)
+				continue
+			}
+
+			group := key[:common.AddressLength+8]
+			if bytes.Equal(group, lastGroup) {
+				continue
+			}
+			lastGroup = append(lastGroup[:0], group...)
+
+			addr, incarnation, _ := types.SplitStorageKey(key)
+			currentIncarnation := k.GetIncarnation(ctx, addr)
+			switch {
+			case incarnation < currentIncarnation:
+				if !store.Has(types.StaleStorageKey(addr, incarnation)) {
+					count++
+					msg += fmt.Sprintf("\taccount %s has unreachable storage for the incarnation %d\n", addr, incarnation)
+				}
+			case incarnation > currentIncarnation:
+				count++
+				msg += fmt.Sprintf("\taccount %s has storage for the future incarnation %d\n", addr, incarnation)
+			case !k.GetAccountOrEmpty(ctx, addr).IsContract():
+				count++
+				msg += fmt.Sprintf("\taccount %s without code has storage\n", addr)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "contract-storage",
+			fmt.Sprintf("accounts with an invalid storage found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// ModuleAccountBalanceInvariant checks that the evm module account holds no balance, the
+// coins it mints or receives are always burned or sent within the same transaction.
+func ModuleAccountBalanceInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		balances := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+
+		broken := !balances.IsZero()
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-account-balance",
+			fmt.Sprintf("\tevm module account balance: %s\n", balances),
+		), broken
+	}
+}
+
+// TransientCountersInvariant checks that the transient state is reset between blocks:
+// the block bloom is only recorded for the current height, and the log size, the gas
+// used and the bloom are zero until the first Ethereum transaction of the block is
+// processed.
+func TransientCountersInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		// #nosec G115 block height always positive
+		heightBz := sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()))
+		bloomStore := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientBloom)
+		iterator := bloomStore.Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			if string(iterator.Key()) != string(heightBz) {
+				count++
+				msg += fmt.Sprintf("\tblock bloom recorded for the height %d\n", sdk.BigEndianToUint64(iterator.Key()))
+			}
+		}
+		iterator.Close()
+
+		if k.GetTxIndexTransient(ctx) == 0 {
+			if logSize := k.GetLogSizeTransient(ctx); logSize != 0 {
+				count++
+				msg += fmt.Sprintf("\tlog size %d before the first transaction\n", logSize)
+			}
+			if gasUsed := k.GetTransientGasUsed(ctx); gasUsed != 0 {
+				count++
+				msg += fmt.Sprintf("\tgas used %d before the first transaction\n", gasUsed)
+			}
+			if gasUsed := k.GetBlockGasUsedTransient(ctx); gasUsed != 0 {
+				count++
+				msg += fmt.Sprintf("\tblock gas used %d before the first transaction\n", gasUsed)
+			}
+			if k.GetBlockBloomTransient(ctx).Sign() != 0 {
+				count++
+				msg += "\tblock bloom set before the first transaction\n"
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "transient-counters",
+			fmt.Sprintf("transient counters not reset found %d\n%s", count, msg),
+		), broken
+	}
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/ethermint/tests"
+	ethermint "github.com/zeta-chain/ethermint/types"
+	"github.com/zeta-chain/ethermint/x/evm/keeper"
+	"github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	testCases := []struct {
+		name      string
+		malleate  func()
+		invariant func(k *keeper.Keeper) sdk.Invariant
+		expBroken bool
+	}{
+		{
+			"contract - pass",
+			func() {
+				suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+			},
+			keeper.AllInvariants,
+			false,
+		},
+		{
+			"account without stored code - fail",
+			func() {
+				acc := suite.app.AccountKeeper.GetAccount(suite.ctx, suite.address.Bytes())
+				ethAcc, ok := acc.(ethermint.EthAccountI)
+				suite.Require().True(ok)
+				suite.Require().NoError(ethAcc.SetCodeHash(common.BytesToHash([]byte("missing"))))
+				suite.app.AccountKeeper.SetAccount(suite.ctx, ethAcc)
+			},
+			keeper.AccountCodeInvariant,
+			true,
+		},
+		{
+			"storage of an account without code - fail",
+			func() {
+				suite.app.EvmKeeper.SetState(suite.ctx, suite.address, common.BytesToHash([]byte("key")), []byte{1})
+			},
+			keeper.ContractStorageInvariant,
+			true,
+		},
+		{
+			"storage of a deleted account incarnation - pass",
+			func() {
+				addr := tests.GenerateAddress()
+				suite.app.EvmKeeper.SetState(suite.ctx, addr, common.BytesToHash([]byte("key")), []byte{1})
+				suite.app.EvmKeeper.DeleteStorage(suite.ctx, addr)
+			},
+			keeper.ContractStorageInvariant,
+			false,
+		},
+		{
+			"unreachable storage not marked as stale - fail",
+			func() {
+				addr := tests.GenerateAddress()
+				suite.app.EvmKeeper.DeleteStorage(suite.ctx, addr)
+				store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+				store.Set(types.StateKey(addr, 0, common.BytesToHash([]byte("key")).Bytes()), []byte{1})
+			},
+			keeper.ContractStorageInvariant,
+			true,
+		},
+		{
+			"evm module account balance - fail",
+			func() {
+				coins := sdk.NewCoins(sdk.NewCoin(suite.EvmDenom(), sdkmath.NewInt(1)))
+				suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+			},
+			keeper.ModuleAccountBalanceInvariant,
+			true,
+		},
+		{
+			"transient log size before the first transaction - fail",
+			func() {
+				suite.app.EvmKeeper.SetTxIndexTransient(suite.ctx, 0)
+				suite.app.EvmKeeper.SetLogSizeTransient(suite.ctx, 1)
+			},
+			keeper.TransientCountersInvariant,
+			true,
+		},
+		{
+			"transient block gas used before the first transaction - fail",
+			func() {
+				_, err := suite.app.EvmKeeper.AddBlockGasUsedTransient(suite.ctx, 21000)
+				suite.Require().NoError(err)
+			},
+			keeper.TransientCountersInvariant,
+			true,
+		},
+		{
+			"transient bloom of a previous block - fail",
+			func() {
+				suite.app.EvmKeeper.SetBlockBloomTransient(suite.ctx.WithBlockHeight(suite.ctx.BlockHeight()-1), big.NewInt(1))
+			},
+			keeper.TransientCountersInvariant,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			msg, broken := tc.invariant(suite.app.EvmKeeper)(suite.ctx)
+			suite.Require().Equal(tc.expBroken, broken, msg)
+		})
+	}
+}
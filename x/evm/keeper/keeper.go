@@ -16,6 +16,7 @@
 package keeper
 
 import (
+	"encoding/json"
 	"math/big"
 
 	"cosmossdk.io/api/tendermint/abci"
@@ -42,6 +43,10 @@ import (
 // CustomContractFn defines a custom precompiled contract generator with ctx, rules and returns a precompiled contract.
 type CustomContractFn func(sdk.Context, params.Rules) vm.StatefulPrecompiledContract
 
+// ParamsContractsFn defines a generator of the precompiled contracts enabled by the evm
+// params, e.g. a contract per denom of a registry param.
+type ParamsContractsFn func(sdk.Context, params.Rules, types.Params) []vm.StatefulPrecompiledContract
+
 // EventConverter type represents a function that parses a list of EventAttributes to a list of Ethereum Log objects.
 type EventConverter = func([]abci.EventAttribute) []*ethtypes.Log
 
@@ -76,11 +81,22 @@ type Keeper struct {
 
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
+	// json config of the native tracer
+	tracerConfig json.RawMessage
+	// destination of the native tracer results, tracing is disabled if nil
+	traceSink types.TraceSink
 
 	// EVM Hooks for tx post-processing
 	hooks types.EvmHooks
 
+	// oracle providing the conversion rates of the alternative fee denoms, the params
+	// rates are used if nil
+	feeRateOracle types.FeeRateOracle
+
 	customContractFns []CustomContractFn
+	// generators of the precompiled contracts enabled by the params, they are active
+	// without being listed in the active precompiles
+	paramsContractsFns []ParamsContractsFn
 
 	// a set of store keys that should cover all the precompile use cases,
 	// or ideally just pass the application's all stores.
@@ -220,6 +236,46 @@ func (k Keeper) GetTxIndexTransient(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(bz)
 }
 
+// SetFeePaymentTransient records the fee paid in an alternative denom by the given
+// transaction, so that the leftover gas is refunded in the same denom.
+func (k Keeper) SetFeePaymentTransient(ctx sdk.Context, txHash common.Hash, fee sdk.Coin) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayment)
+	store.Set(txHash.Bytes(), k.cdc.MustMarshal(&fee))
+}
+
+// GetFeePaymentTransient returns the fee paid in an alternative denom by the given
+// transaction, it returns false if the fee has been paid in the evm denom.
+func (k Keeper) GetFeePaymentTransient(ctx sdk.Context, txHash common.Hash) (sdk.Coin, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayment)
+	bz := store.Get(txHash.Bytes())
+	if len(bz) == 0 {
+		return sdk.Coin{}, false
+	}
+
+	var fee sdk.Coin
+	k.cdc.MustUnmarshal(bz, &fee)
+	return fee, true
+}
+
+// SetFeePayerTransient records the fee payer of a bundled transaction, so that the
+// leftover gas is refunded to the account which paid the fee.
+func (k Keeper) SetFeePayerTransient(ctx sdk.Context, txHash common.Hash, payer common.Address) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayer)
+	store.Set(txHash.Bytes(), payer.Bytes())
+}
+
+// GetFeePayerTransient returns the fee payer of the given transaction, it returns false
+// if the fee has been paid by the sender.
+func (k Keeper) GetFeePayerTransient(ctx sdk.Context, txHash common.Hash) (common.Address, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayer)
+	bz := store.Get(txHash.Bytes())
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+
+	return common.BytesToAddress(bz), true
+}
+
 // ----------------------------------------------------------------------------
 // Log
 // ----------------------------------------------------------------------------
@@ -273,6 +329,56 @@ func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
 	return k
 }
 
+// PrecompileAddresses returns the addresses of all the precompiled contracts registered
+// on the keeper, whether they are active in the given params or not.
+func (k Keeper) PrecompileAddresses(ctx sdk.Context, params types.Params) []common.Address {
+	ethCfg := params.ChainConfig.EthereumConfig(k.eip155ChainID)
+	rules := ethCfg.Rules(big.NewInt(ctx.BlockHeight()), ethCfg.MergeNetsplitBlock != nil, ethermint.BlockTime(ctx))
+
+	addresses := make([]common.Address, len(k.customContractFns))
+	for i, fn := range k.customContractFns {
+		addresses[i] = fn(ctx, rules).Address()
+	}
+	return addresses
+}
+
+// SetTracerConfig sets the json config used to create the native tracer.
+func (k *Keeper) SetTracerConfig(tracerConfig json.RawMessage) *Keeper {
+	k.tracerConfig = tracerConfig
+	return k
+}
+
+// SetTraceSink sets the sink the native tracer results of the transactions
+// executed in a block are written to.
+func (k *Keeper) SetTraceSink(sink types.TraceSink) *Keeper {
+	k.traceSink = sink
+	return k
+}
+
+// SetFeeRateOracle sets the oracle providing the conversion rates of the alternative
+// fee denoms, its rates take precedence over the params ones.
+func (k *Keeper) SetFeeRateOracle(oracle types.FeeRateOracle) *Keeper {
+	k.feeRateOracle = oracle
+	return k
+}
+
+// SetParamsContractsFns sets the generators of the precompiled contracts enabled by the
+// evm params.
+func (k *Keeper) SetParamsContractsFns(fns ...ParamsContractsFn) *Keeper {
+	k.paramsContractsFns = fns
+	return k
+}
+
+// PreTxProcessing delegate the call to the hooks if they implement `types.EvmPreTxHooks`. If no hook
+// has been registered, this function returns with a `nil` error
+func (k *Keeper) PreTxProcessing(ctx sdk.Context, msg *core.Message) error {
+	preTxHooks, ok := k.hooks.(types.EvmPreTxHooks)
+	if !ok {
+		return nil
+	}
+	return preTxHooks.PreTxProcessing(ctx, msg)
+}
+
 // PostTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
 func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg *core.Message, receipt *ethtypes.Receipt) error {
 	if k.hooks == nil {
@@ -283,7 +389,7 @@ func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg *core.Message, receipt *e
 
 // Tracer return a default vm.Tracer based on current keeper state
 func (k Keeper) Tracer(ctx sdk.Context, msg *core.Message, ethCfg *params.ChainConfig) vm.EVMLogger {
-	return NewTracer(k.tracer, msg, ethCfg, ctx.BlockHeight())
+	return NewTracer(k.tracer, msg, ethCfg, ctx.BlockHeight(), ethermint.BlockTime(ctx))
 }
 
 // GetAccountWithoutBalance load nonce and codehash without balance,
@@ -407,3 +513,30 @@ func (k Keeper) AddTransientGasUsed(ctx sdk.Context, gasUsed uint64) (uint64, er
 	k.SetTransientGasUsed(ctx, result)
 	return result, nil
 }
+
+// GetBlockGasUsedTransient returns the gas used by the EVM transactions of the current block.
+func (k Keeper) GetBlockGasUsedTransient(ctx sdk.Context) uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientBlockGasUsed)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// AddBlockGasUsedTransient accumulates the gas used by the EVM transactions of the current block.
+func (k Keeper) AddBlockGasUsedTransient(ctx sdk.Context, gasUsed uint64) (uint64, error) {
+	result := k.GetBlockGasUsedTransient(ctx) + gasUsed
+	if result < gasUsed {
+		return 0, errorsmod.Wrap(types.ErrGasOverflow, "block gas used")
+	}
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientBlockGasUsed, sdk.Uint64ToBigEndian(result))
+	return result, nil
+}
+
+// BlockGasLimit returns the gas limit of the EVM transactions in the current block, the
+// block_gas_limit param capped by the consensus params max gas.
+func (k Keeper) BlockGasLimit(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).EVMBlockGasLimit(ethermint.BlockGasLimit(ctx))
+}
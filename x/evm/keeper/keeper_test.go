@@ -34,6 +34,7 @@ import (
 	"github.com/zeta-chain/ethermint/server/config"
 	"github.com/zeta-chain/ethermint/tests"
 	ethermint "github.com/zeta-chain/ethermint/types"
+	"github.com/zeta-chain/ethermint/x/evm/precompiles"
 	"github.com/zeta-chain/ethermint/x/evm/statedb"
 	"github.com/zeta-chain/ethermint/x/evm/types"
 	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
@@ -200,6 +201,14 @@ func (suite *KeeperTestSuite) EvmDenom() string {
 	return rsp.Params.EvmDenom
 }
 
+// DefaultParams returns the evm params of the app default genesis, which activates the
+// precompiles registered by the app
+func (suite *KeeperTestSuite) DefaultParams() types.Params {
+	params := types.DefaultParams()
+	params.ActivePrecompiles = []string{precompiles.StakingContractAddress.Hex()}
+	return params
+}
+
 // Commit and begin new block
 func (suite *KeeperTestSuite) Commit() {
 	jumpTime := time.Second * 0
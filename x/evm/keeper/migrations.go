@@ -16,6 +16,12 @@
 package keeper
 
 import (
+	"slices"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/zeta-chain/ethermint/x/evm/types"
 )
 
@@ -32,3 +38,70 @@ func NewMigrator(keeper Keeper, legacySubspace types.Subspace) Migrator {
 		legacySubspace: legacySubspace,
 	}
 }
+
+// Migrate5to6 migrates the store from consensus version 5 to 6. It activates all the
+// precompiled contracts registered on the keeper so that they remain available after
+// the introduction of the active_precompiles param.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	active := params.ActivePrecompileAddresses()
+	for _, addr := range m.keeper.PrecompileAddresses(ctx, params) {
+		if !slices.Contains(active, addr) {
+			params.ActivePrecompiles = append(params.ActivePrecompiles, addr.Hex())
+		}
+	}
+	return m.keeper.SetParams(ctx, params)
+}
+
+// storageMigrationBatchSize is the number of storage slots read at once by Migrate6to7.
+const storageMigrationBatchSize = 10000
+
+// Migrate6to7 migrates the store from consensus version 6 to 7. It moves the contract
+// storage slots from the legacy `prefix | address | key` layout to the incarnation
+// based `prefix | address | incarnation | key` layout, with the initial incarnation.
+// The slots are streamed in bounded batches since the store can't be written while
+// it is iterated.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	storageStore := prefix.NewStore(store, types.KeyPrefixStorage)
+
+	var start []byte
+	for {
+		keys, values := legacyStorageBatch(storageStore, start, storageMigrationBatchSize)
+		for i, key := range keys {
+			addr := common.BytesToAddress(key[:common.AddressLength])
+			storageStore.Delete(key)
+			store.Set(types.StateKey(addr, 0, key[common.AddressLength:]), values[i])
+		}
+		if len(keys) < storageMigrationBatchSize {
+			return nil
+		}
+		// resume right after the last legacy key, the migrated keys are skipped
+		start = append(append([]byte{}, keys[len(keys)-1]...), 0)
+	}
+}
+
+// Migrate7to8 migrates the store from consensus version 7 to 8. It sets the
+// scheduled_calls_gas_limit param to its default value, the scheduled calls are not
+// executed with a zero limit.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.ScheduledCallsGasLimit = types.DefaultScheduledCallsGasLimit
+	return m.keeper.SetParams(ctx, params)
+}
+
+// legacyStorageBatch returns up to limit storage slots in the legacy layout from the
+// start key, the slots in the incarnation based layout are skipped.
+func legacyStorageBatch(storageStore storetypes.KVStore, start []byte, limit int) (keys, values [][]byte) {
+	iterator := storageStore.Iterator(start, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid() && len(keys) < limit; iterator.Next() {
+		if len(iterator.Key()) != common.AddressLength+common.HashLength {
+			continue
+		}
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	return keys, values
+}
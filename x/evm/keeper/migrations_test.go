@@ -1,7 +1,11 @@
 package keeper_test
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmkeeper "github.com/zeta-chain/ethermint/x/evm/keeper"
 	"github.com/zeta-chain/ethermint/x/evm/types"
 )
 
@@ -18,10 +22,26 @@ func (ms mockSubspace) GetParamSetIfExists(_ sdk.Context, ps types.LegacyParams)
 }
 
 func (suite *KeeperTestSuite) TestMigrations() {
+	legacySubspace := newMockSubspace(types.DefaultParams())
+	migrator := evmkeeper.NewMigrator(*suite.app.EvmKeeper, legacySubspace)
+
 	testCases := []struct {
 		name        string
 		migrateFunc func(ctx sdk.Context) error
-	}{}
+	}{
+		{
+			"Run Migrate5to6",
+			migrator.Migrate5to6,
+		},
+		{
+			"Run Migrate6to7",
+			migrator.Migrate6to7,
+		},
+		{
+			"Run Migrate7to8",
+			migrator.Migrate7to8,
+		},
+	}
 
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
@@ -30,3 +50,41 @@ func (suite *KeeperTestSuite) TestMigrations() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigrate6to7() {
+	migrator := evmkeeper.NewMigrator(*suite.app.EvmKeeper, newMockSubspace(types.DefaultParams()))
+
+	key := common.BytesToHash([]byte("key"))
+	value := common.BytesToHash([]byte("value"))
+	legacyKey := append(append(types.KeyPrefixStorage, suite.address.Bytes()...), key.Bytes()...)
+
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Set(legacyKey, value.Bytes())
+
+	// slots of other accounts, more than a migration batch
+	for i := 1; i <= 10001; i++ {
+		addr := common.BigToAddress(big.NewInt(int64(i)))
+		store.Set(append(append(types.KeyPrefixStorage, addr.Bytes()...), key.Bytes()...), value.Bytes())
+	}
+
+	suite.Require().NoError(migrator.Migrate6to7(suite.ctx))
+	suite.Require().False(store.Has(legacyKey))
+	suite.Require().Equal(value, suite.app.EvmKeeper.GetState(suite.ctx, suite.address, key))
+	for i := 1; i <= 10001; i++ {
+		addr := common.BigToAddress(big.NewInt(int64(i)))
+		suite.Require().Equal(value, suite.app.EvmKeeper.GetState(suite.ctx, addr, key))
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigrate7to8() {
+	migrator := evmkeeper.NewMigrator(*suite.app.EvmKeeper, newMockSubspace(types.DefaultParams()))
+
+	// the chains upgraded from v7 have no scheduled_calls_gas_limit in their params
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.ScheduledCallsGasLimit = 0
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	suite.Require().NoError(migrator.Migrate7to8(suite.ctx))
+	params.ScheduledCallsGasLimit = types.DefaultScheduledCallsGasLimit
+	suite.Require().Equal(params, suite.app.EvmKeeper.GetParams(suite.ctx))
+}
@@ -19,6 +19,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
+	"slices"
 	"strconv"
 
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -30,6 +32,10 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/hashicorp/go-metrics"
 
 	"github.com/zeta-chain/ethermint/x/evm/types"
@@ -146,6 +152,26 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 	return response, nil
 }
 
+// EthereumTxBundle implements the gRPC MsgServer interface. It executes the transactions of
+// the bundle in order and atomically: if any of them fails or reverts, an error is returned so
+// that the state changes of the whole bundle are reverted. The nonces and the fees deducted by
+// the ante handler are kept.
+func (k *Keeper) EthereumTxBundle(goCtx context.Context, msg *types.MsgEthereumTxBundle) (*types.MsgEthereumTxBundleResponse, error) {
+	responses := make([]*types.MsgEthereumTxResponse, len(msg.Txs))
+	for i, tx := range msg.Txs {
+		res, err := k.EthereumTx(goCtx, tx)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "bundled tx %d (%s)", i, tx.Hash)
+		}
+		if res.Failed() {
+			return nil, errorsmod.Wrapf(types.ErrBundleTxFailed, "tx %d (%s): %s", i, tx.Hash, res.VmError)
+		}
+		responses[i] = res
+	}
+
+	return &types.MsgEthereumTxBundleResponse{Responses: responses}, nil
+}
+
 // UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
 // proposal passes, it updates the module parameters. The update can only be
 // performed if the requested authority is the Cosmos SDK governance module
@@ -156,9 +182,200 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// only the precompiled contracts registered on the keeper can be activated
+	registered := k.PrecompileAddresses(ctx, req.Params)
+	for _, addr := range req.Params.ActivePrecompileAddresses() {
+		if !slices.Contains(registered, addr) {
+			return nil, errorsmod.Wrapf(types.ErrUnknownPrecompile, "address %s", addr.Hex())
+		}
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// CallContract implements the gRPC MsgServer interface. When a CallContract proposal
+// passes, it calls the contract from the EVM address of the authority with the given
+// value and call data. The value is paid from the contract funds module account. A failed
+// call returns an error, reverting the proposal execution.
+func (k *Keeper) CallContract(goCtx context.Context, req *types.MsgCallContract) (*types.MsgCallContractResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	contract := common.HexToAddress(req.Contract)
+	value, err := k.fundContractMsg(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	res, err := k.callEVMWithValue(ctx, common.BytesToAddress(k.authority), &contract, value, req.Data, req.GasLimit, true)
+	if err != nil {
+		return nil, err
+	}
+	if res.Failed() {
+		return nil, revertError(nil, res)
+	}
+
+	return &types.MsgCallContractResponse{
+		Ret:     res.Ret,
+		Logs:    res.Logs,
+		GasUsed: res.GasUsed,
+	}, nil
+}
+
+// DeployContract implements the gRPC MsgServer interface. When a DeployContract proposal
+// passes, it deploys the contract init code from the EVM address of the authority with the
+// given value, paid from the contract funds module account. A failed deployment returns an
+// error, reverting the proposal execution.
+func (k *Keeper) DeployContract(goCtx context.Context, req *types.MsgDeployContract) (*types.MsgDeployContractResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	from := common.BytesToAddress(k.authority)
+	nonce := k.GetNonce(ctx, from)
+	value, err := k.fundContractMsg(ctx, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	res, err := k.callEVMWithValue(ctx, from, nil, value, req.Data, req.GasLimit, true)
+	if err != nil {
+		return nil, err
+	}
+	if res.Failed() {
+		return nil, revertError(nil, res)
+	}
+
+	return &types.MsgDeployContractResponse{
+		ContractAddress: crypto.CreateAddress(from, nonce).Hex(),
+		Logs:            res.Logs,
+		GasUsed:         res.GasUsed,
+	}, nil
+}
+
+// RegisterScheduledCall implements the gRPC MsgServer interface. When a RegisterScheduledCall
+// proposal passes, it registers a contract call executed at the end of every interval blocks
+// from the start height, or from the next block if the start height has passed.
+func (k *Keeper) RegisterScheduledCall(
+	goCtx context.Context,
+	req *types.MsgRegisterScheduledCall,
+) (*types.MsgRegisterScheduledCallResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// a zero block gas limit is unlimited
+	if blockGasLimit := k.BlockGasLimit(ctx); blockGasLimit > 0 && req.GasLimit > blockGasLimit {
+		return nil, errorsmod.Wrapf(types.ErrInvalidGasLimit, "gas limit %d exceeds the block gas limit %d", req.GasLimit, blockGasLimit)
+	}
+	if limit := k.GetParams(ctx).ScheduledCallsGasLimit; req.GasLimit > limit {
+		return nil, errorsmod.Wrapf(types.ErrInvalidGasLimit, "gas limit %d exceeds the scheduled calls gas limit %d", req.GasLimit, limit)
+	}
+
+	// #nosec G115 block height always positive
+	nextHeight := uint64(ctx.BlockHeight()) + 1
+	if req.StartHeight > nextHeight {
+		nextHeight = req.StartHeight
+	}
+
+	id := k.GetNextScheduledCallID(ctx)
+	call := req.ScheduledCall(id, nextHeight)
+	if err := call.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetScheduledCall(ctx, call)
+	k.SetNextScheduledCallID(ctx, id+1)
+
+	return &types.MsgRegisterScheduledCallResponse{Id: id}, nil
+}
+
+// CancelScheduledCall implements the gRPC MsgServer interface. When a CancelScheduledCall
+// proposal passes, it removes the scheduled call, including a disabled one.
+func (k *Keeper) CancelScheduledCall(
+	goCtx context.Context,
+	req *types.MsgCancelScheduledCall,
+) (*types.MsgCancelScheduledCallResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetScheduledCall(ctx, req.Id); !found {
+		return nil, errorsmod.Wrapf(types.ErrScheduledCallNotFound, "id %d", req.Id)
+	}
+
+	k.DeleteScheduledCall(ctx, req.Id)
+	return &types.MsgCancelScheduledCallResponse{}, nil
+}
+
+// ApproveScheduledCall implements the gRPC MsgServer interface. It sets the amount the payer
+// of a scheduled call allows to be charged for the executions, no fees are charged to a
+// payer which hasn't approved them.
+func (k *Keeper) ApproveScheduledCall(
+	goCtx context.Context,
+	req *types.MsgApproveScheduledCall,
+) (*types.MsgApproveScheduledCallResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	call, found := k.GetScheduledCall(ctx, req.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrScheduledCallNotFound, "id %d", req.Id)
+	}
+
+	if call.Payer != req.Payer {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid payer, expected %s, got %s", call.Payer, req.Payer)
+	}
+
+	call.Allowance = req.Allowance
+	k.SetScheduledCall(ctx, call)
+
+	return &types.MsgApproveScheduledCallResponse{}, nil
+}
+
+// UpdateCreateFactories implements the gRPC MsgServer interface. It adds and removes the
+// factory contracts allowed to deploy contracts, the signer must be the create factory
+// admin of the params.
+func (k *Keeper) UpdateCreateFactories(
+	goCtx context.Context,
+	req *types.MsgUpdateCreateFactories,
+) (*types.MsgUpdateCreateFactoriesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	admin := k.GetParams(ctx).CreateFactoryAdmin
+	if admin == "" || admin != req.Admin {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid create factory admin, expected %s, got %s", admin, req.Admin)
+	}
+
+	for _, factory := range req.Remove {
+		k.DeleteCreateFactory(ctx, common.HexToAddress(factory))
+	}
+	for _, factory := range req.Add {
+		k.SetCreateFactory(ctx, common.HexToAddress(factory))
+	}
+
+	return &types.MsgUpdateCreateFactoriesResponse{}, nil
+}
+
+// fundContractMsg sends the value of a contract call or deployment message from the
+// contract funds module account to the authority, which transfers it to the contract.
+// The authority balance is left unchanged, a nil value is zero.
+func (k *Keeper) fundContractMsg(ctx sdk.Context, value sdkmath.Int) (*big.Int, error) {
+	if value.IsNil() || value.IsZero() {
+		return big.NewInt(0), nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.GetParams(ctx).EvmDenom, value))
+	// the authority is usually a blocked recipient, the coins are sent without the
+	// module account helper
+	funds := authtypes.NewModuleAddress(types.ContractFundsName)
+	if err := k.bankKeeper.SendCoins(ctx, funds, k.authority, coins); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to fund the value from the %s module account", types.ContractFundsName)
+	}
+	return value.BigInt(), nil
+}
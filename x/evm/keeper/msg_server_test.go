@@ -3,11 +3,18 @@ package keeper_test
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/zeta-chain/ethermint/server/config"
+	"github.com/zeta-chain/ethermint/tests"
+	"github.com/zeta-chain/ethermint/testutil"
+	"github.com/zeta-chain/ethermint/x/evm/keeper"
 	"github.com/zeta-chain/ethermint/x/evm/statedb"
 	"github.com/zeta-chain/ethermint/x/evm/types"
 )
@@ -82,6 +89,94 @@ func (suite *KeeperTestSuite) TestEthereumTx() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthereumTxBundle() {
+	recipient := common.BytesToAddress([]byte("recipient"))
+
+	newTransfer := func(nonce uint64, gasLimit uint64) *types.MsgEthereumTx {
+		tx := types.NewTx(suite.app.EvmKeeper.ChainID(), nonce, &recipient, big.NewInt(1), gasLimit, big.NewInt(0), nil, nil, nil, nil)
+		tx.From = suite.address.Hex()
+		suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID()), suite.signer))
+		return tx
+	}
+
+	testCases := []struct {
+		name   string
+		bundle func(nonce uint64) *types.MsgEthereumTxBundle
+		expErr bool
+	}{
+		{
+			"pass - all transactions succeed",
+			func(nonce uint64) *types.MsgEthereumTxBundle {
+				return types.NewMsgEthereumTxBundle(newTransfer(nonce, params.TxGas), newTransfer(nonce+1, params.TxGas))
+			},
+			false,
+		},
+		{
+			"fail - a transaction fails",
+			func(nonce uint64) *types.MsgEthereumTxBundle {
+				signer := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+				chainCfg := suite.app.EvmKeeper.GetParams(suite.ctx).ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+				create, err := suite.createContractMsgTx(nonce+1, signer, chainCfg, big.NewInt(0))
+				suite.Require().NoError(err)
+				return types.NewMsgEthereumTxBundle(newTransfer(nonce, params.TxGas), create)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, suite.address.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(types.DefaultEVMDenom, 10))))
+
+			bundle := tc.bundle(suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+			res, err := suite.app.EvmKeeper.EthereumTxBundle(suite.ctx, bundle)
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Responses, len(bundle.Txs))
+			for i, txRes := range res.Responses {
+				suite.Require().Equal(bundle.Txs[i].Hash, txRes.Hash)
+				suite.Require().False(txRes.Failed())
+			}
+			suite.Require().Equal(int64(2), suite.app.BankKeeper.GetBalance(suite.ctx, recipient.Bytes(), types.DefaultEVMDenom).Amount.Int64())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEthereumTxBundleFeePayer() {
+	suite.SetupTest()
+
+	feePayer := common.BytesToAddress([]byte("fee_payer"))
+	initBalance := sdk.NewInt64Coin(types.DefaultEVMDenom, 10_000_000)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, feePayer.Bytes()))
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, feePayer.Bytes(), sdk.NewCoins(initBalance)))
+
+	// the sender has no balance, the fees are paid by the fee payer
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	tx := types.NewTx(suite.app.EvmKeeper.ChainID(), nonce, &common.Address{}, nil, 50000, big.NewInt(10), nil, nil, nil, nil)
+	tx.From = suite.address.Hex()
+	suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID()), suite.signer))
+
+	txData, err := types.UnpackTxData(tx.Data)
+	suite.Require().NoError(err)
+	fees, err := keeper.VerifyFee(txData, types.DefaultEVMDenom, big.NewInt(0), true, true, true, false)
+	suite.Require().NoError(err)
+	_, err = suite.app.EvmKeeper.DeductTxCostsFromUserBalance(suite.ctx, fees, feePayer, big.NewInt(0), common.HexToHash(tx.Hash))
+	suite.Require().NoError(err)
+	suite.app.EvmKeeper.SetFeePayerTransient(suite.ctx, common.HexToHash(tx.Hash), feePayer)
+
+	res, err := suite.app.EvmKeeper.EthereumTxBundle(suite.ctx, types.NewMsgEthereumTxBundle(tx))
+	suite.Require().NoError(err)
+
+	// the leftover gas is refunded to the fee payer
+	paid := sdkmath.NewIntFromUint64(res.Responses[0].GasUsed * 10)
+	suite.Require().Equal(initBalance.Amount.Sub(paid), suite.app.BankKeeper.GetBalance(suite.ctx, feePayer.Bytes(), types.DefaultEVMDenom).Amount)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), types.DefaultEVMDenom).IsZero())
+}
+
 func (suite *KeeperTestSuite) TestUpdateParams() {
 	testCases := []struct {
 		name      string
@@ -101,6 +196,18 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 			},
 			expectErr: false,
 		},
+		{
+			name: "fail - unknown active precompile",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.ActivePrecompiles = []string{"0x0000000000000000000000000000000000000065"}
+					return params
+				}(),
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -115,3 +222,120 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateCreateFactories() {
+	admin := sdk.AccAddress(suite.address.Bytes()).String()
+	factory := common.BigToAddress(big.NewInt(0x1234))
+	msg := &types.MsgUpdateCreateFactories{Admin: admin, Add: []string{factory.Hex()}}
+
+	// no admin is set in the params
+	_, err := suite.app.EvmKeeper.UpdateCreateFactories(suite.ctx, msg)
+	suite.Require().Error(err)
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.CreateFactoryAdmin = admin
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	_, err = suite.app.EvmKeeper.UpdateCreateFactories(suite.ctx, &types.MsgUpdateCreateFactories{
+		Admin: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Add:   []string{factory.Hex()},
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.app.EvmKeeper.UpdateCreateFactories(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.EvmKeeper.IsCreateFactory(suite.ctx, factory))
+	suite.Require().Equal([]string{factory.Hex()}, suite.app.EvmKeeper.GetCreateFactories(suite.ctx))
+
+	_, err = suite.app.EvmKeeper.UpdateCreateFactories(suite.ctx, &types.MsgUpdateCreateFactories{
+		Admin:  admin,
+		Remove: []string{factory.Hex()},
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.EvmKeeper.IsCreateFactory(suite.ctx, factory))
+	suite.Require().Empty(suite.app.EvmKeeper.GetCreateFactories(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestDeployAndCallContract() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	erc20 := types.ERC20Contract
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	govAddr := common.BytesToAddress(authority)
+	supply := big.NewInt(1000)
+	gasLimit := uint64(config.DefaultGasCap)
+
+	ctorArgs, err := erc20.ABI.Pack("", govAddr, supply)
+	suite.Require().NoError(err)
+	deployMsg := &types.MsgDeployContract{
+		Authority: authority.String(),
+		Data:      append(append([]byte{}, erc20.Bin...), ctorArgs...),
+		GasLimit:  gasLimit,
+	}
+
+	// only the authority can deploy contracts
+	_, err = k.DeployContract(suite.ctx, &types.MsgDeployContract{Authority: suite.address.String(), Data: deployMsg.Data, GasLimit: gasLimit})
+	suite.Require().Error(err)
+
+	deployRes, err := k.DeployContract(suite.ctx, deployMsg)
+	suite.Require().NoError(err)
+	contract := common.HexToAddress(deployRes.ContractAddress)
+	suite.Require().NotEmpty(k.GetCode(suite.ctx, common.BytesToHash(k.GetAccountOrEmpty(suite.ctx, contract).CodeHash)))
+
+	recipient := tests.GenerateAddress()
+	data, err := erc20.ABI.Pack("transfer", recipient, big.NewInt(100))
+	suite.Require().NoError(err)
+	callMsg := &types.MsgCallContract{
+		Authority: authority.String(),
+		Contract:  contract.Hex(),
+		Data:      data,
+		GasLimit:  gasLimit,
+	}
+
+	_, err = k.CallContract(suite.ctx, &types.MsgCallContract{Authority: suite.address.String(), Contract: contract.Hex(), Data: data, GasLimit: gasLimit})
+	suite.Require().Error(err)
+
+	callRes, err := k.CallContract(suite.ctx, callMsg)
+	suite.Require().NoError(err)
+	suite.Require().Len(callRes.Logs, 1)
+	suite.Require().Equal(contract.Hex(), callRes.Logs[0].Address)
+	values, err := erc20.ABI.Unpack("transfer", callRes.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal(true, values[0])
+
+	values, err = k.CallEVM(suite.ctx, govAddr, contract, erc20.ABI, "balanceOf", gasLimit, false, recipient)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(100), values[0])
+
+	// a reverted call fails the message
+	callMsg.Data, err = erc20.ABI.Pack("transfer", recipient, big.NewInt(10000))
+	suite.Require().NoError(err)
+	_, err = k.CallContract(suite.ctx, callMsg)
+	suite.Require().ErrorIs(err, types.ErrExecutionReverted)
+
+	// the value is paid from the contract funds module account, not from the proposal
+	// deposits held by the authority
+	deposits := sdk.NewCoins(sdk.NewInt64Coin(suite.EvmDenom(), 1000))
+	funds := sdk.NewCoins(sdk.NewInt64Coin(suite.EvmDenom(), 500))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, deposits.Add(funds...)))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, types.ModuleName, govtypes.ModuleName, deposits))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, types.ModuleName, types.ContractFundsName, funds))
+	fundsAddr := common.BytesToAddress(authtypes.NewModuleAddress(types.ContractFundsName))
+	valueMsg := &types.MsgCallContract{
+		Authority: authority.String(),
+		Contract:  recipient.Hex(),
+		Value:     sdkmath.NewInt(400),
+		GasLimit:  gasLimit,
+	}
+	_, err = k.CallContract(suite.ctx, valueMsg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(1000), k.GetBalance(suite.ctx, govAddr))
+	suite.Require().Equal(big.NewInt(100), k.GetBalance(suite.ctx, fundsAddr))
+	suite.Require().Equal(big.NewInt(400), k.GetBalance(suite.ctx, recipient))
+
+	// the deposits don't cover the value missing from the contract funds
+	_, err = k.CallContract(suite.ctx, valueMsg)
+	suite.Require().Error(err)
+	suite.Require().Equal(big.NewInt(1000), k.GetBalance(suite.ctx, govAddr))
+	suite.Require().Equal(big.NewInt(100), k.GetBalance(suite.ctx, fundsAddr))
+}
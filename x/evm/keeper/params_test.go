@@ -18,7 +18,7 @@ func (suite *KeeperTestSuite) TestParams() {
 		{
 			"success - Checks if the default params are set correctly",
 			func() interface{} {
-				return types.DefaultParams()
+				return suite.DefaultParams()
 			},
 			func() interface{} {
 				return suite.app.EvmKeeper.GetParams(suite.ctx)
@@ -0,0 +1,320 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+
+	"github.com/zeta-chain/ethermint/x/evm/precompiles"
+	"github.com/zeta-chain/ethermint/x/evm/statedb"
+	"github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+// precompileEVM returns an EVM with the given stateful precompiled contracts.
+func (suite *KeeperTestSuite) precompileEVM(stateDB *statedb.StateDB, contracts ...vm.StatefulPrecompiledContract) *vm.EVM {
+	cfg, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, suite.ctx.BlockHeader().ProposerAddress, big.NewInt(9000))
+	suite.Require().NoError(err)
+	msg := &core.Message{From: suite.address, GasPrice: big.NewInt(0), Value: big.NewInt(0)}
+	evm := suite.app.EvmKeeper.NewEVM(suite.ctx, msg, cfg, nil, stateDB)
+	evm.SetStatefulPrecompiles(contracts)
+	return evm
+}
+
+func (suite *KeeperTestSuite) TestBankPrecompile() {
+	denom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	amount := sdkmath.NewInt(1000)
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, amount))))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.address.Bytes(), sdk.NewCoins(sdk.NewCoin(denom, amount))))
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+		Base:    denom,
+		Display: "atom",
+		Name:    "Cosmos Hub Atom",
+		Symbol:  "ATOM",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+	})
+
+	contract := precompiles.NewBankContract(suite.app.BankKeeper, denom)
+	spender := common.BigToAddress(big.NewInt(1))
+	recipient := common.BigToAddress(big.NewInt(2))
+
+	call := func(evm *vm.EVM, caller common.Address, method string, args ...interface{}) ([]interface{}, error) {
+		input, err := precompiles.BankABI.Pack(method, args...)
+		suite.Require().NoError(err)
+		ret, _, err := evm.Call(vm.AccountRef(caller), contract.Address(), input, 100000, uint256.NewInt(0))
+		if err != nil {
+			return nil, err
+		}
+		return precompiles.BankABI.Unpack(method, ret)
+	}
+
+	stateDB := suite.StateDB()
+	evm := suite.precompileEVM(stateDB, contract)
+
+	res, err := call(evm, suite.address, "decimals")
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint8(6), res[0])
+	res, err = call(evm, suite.address, "symbol")
+	suite.Require().NoError(err)
+	suite.Require().Equal("ATOM", res[0])
+	res, err = call(evm, suite.address, "totalSupply")
+	suite.Require().NoError(err)
+	suite.Require().Equal(amount.BigInt(), res[0])
+	res, err = call(evm, suite.address, "balanceOf", suite.address)
+	suite.Require().NoError(err)
+	suite.Require().Equal(amount.BigInt(), res[0])
+
+	// transfer
+	_, err = call(evm, suite.address, "transfer", recipient, big.NewInt(100))
+	suite.Require().NoError(err)
+	_, err = call(evm, suite.address, "transfer", recipient, big.NewInt(10000))
+	suite.Require().Error(err)
+
+	// approve and transferFrom
+	_, err = call(evm, suite.address, "approve", spender, big.NewInt(50))
+	suite.Require().NoError(err)
+	res, err = call(evm, suite.address, "allowance", suite.address, spender)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(50), res[0])
+	_, err = call(evm, spender, "transferFrom", suite.address, recipient, big.NewInt(60))
+	suite.Require().Error(err)
+	_, err = call(evm, spender, "transferFrom", suite.address, recipient, big.NewInt(20))
+	suite.Require().NoError(err)
+	res, err = call(evm, suite.address, "allowance", suite.address, spender)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(30), res[0])
+
+	// the writes are rejected in a static call
+	input, err := precompiles.BankABI.Pack("transfer", recipient, big.NewInt(1))
+	suite.Require().NoError(err)
+	_, _, err = evm.StaticCall(vm.AccountRef(suite.address), contract.Address(), input, 100000)
+	suite.Require().ErrorIs(err, vm.ErrWriteProtection)
+
+	logs := stateDB.Logs()
+	suite.Require().Len(logs, 3)
+	suite.Require().Equal(precompiles.BankABI.Events["Transfer"].ID, logs[0].Topics[0])
+	suite.Require().Equal(common.BytesToHash(suite.address.Bytes()), logs[0].Topics[1])
+	suite.Require().Equal(common.BytesToHash(recipient.Bytes()), logs[0].Topics[2])
+	suite.Require().Equal(common.BigToHash(big.NewInt(100)).Bytes(), logs[0].Data)
+	suite.Require().Equal(precompiles.BankABI.Events["Approval"].ID, logs[1].Topics[0])
+	suite.Require().Equal(common.BytesToHash(spender.Bytes()), logs[1].Topics[2])
+	suite.Require().Equal(precompiles.BankABI.Events["Transfer"].ID, logs[2].Topics[0])
+	suite.Require().Equal(contract.Address(), logs[2].Address)
+
+	suite.Require().NoError(stateDB.Commit())
+	suite.Require().Equal(sdkmath.NewInt(880), suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), denom).Amount)
+	suite.Require().Equal(sdkmath.NewInt(120), suite.app.BankKeeper.GetBalance(suite.ctx, recipient.Bytes(), denom).Amount)
+
+	// the allowances are kept in the module store, the contract has no EVM storage
+	suite.Require().Equal(big.NewInt(30), suite.app.EvmKeeper.GetBankAllowance(suite.ctx, contract.Address(), suite.address, spender))
+	suite.app.EvmKeeper.ForEachStorage(suite.ctx, contract.Address(), func(common.Hash, common.Hash) bool {
+		suite.Fail("unexpected storage of the bank precompile")
+		return false
+	})
+
+	// the value transfers to the contract are rejected
+	stateDB = suite.StateDB()
+	stateDB.AddBalance(suite.address, uint256.NewInt(10))
+	evm = suite.precompileEVM(stateDB, contract)
+	input, err = precompiles.BankABI.Pack("balanceOf", suite.address)
+	suite.Require().NoError(err)
+	_, _, err = evm.Call(vm.AccountRef(suite.address), contract.Address(), input, 100000, uint256.NewInt(1))
+	suite.Require().Error(err)
+	suite.Require().True(stateDB.GetBalance(contract.Address()).IsZero())
+
+	// the evm denom is managed by the StateDB and can't be exposed
+	evmContract := precompiles.NewBankContract(suite.app.BankKeeper, suite.EvmDenom())
+	evm = suite.precompileEVM(suite.StateDB(), evmContract)
+	input, err = precompiles.BankABI.Pack("totalSupply")
+	suite.Require().NoError(err)
+	_, _, err = evm.Call(vm.AccountRef(suite.address), evmContract.Address(), input, 100000, uint256.NewInt(0))
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestStakingPrecompile() {
+	valAddr := sdk.ValAddress(suite.address.Bytes())
+	suite.Require().NoError(suite.app.DistrKeeper.Hooks().AfterValidatorCreated(suite.ctx, valAddr))
+	// bond the evm denom to cover the balances managed by the StateDB
+	stakingParams, err := suite.app.StakingKeeper.GetParams(suite.ctx)
+	suite.Require().NoError(err)
+	bondDenom := suite.EvmDenom()
+	stakingParams.BondDenom = bondDenom
+	suite.Require().NoError(suite.app.StakingKeeper.SetParams(suite.ctx, stakingParams))
+
+	contract := precompiles.NewStakingContract(suite.app.StakingKeeper, suite.app.DistrKeeper)
+	call := func(evm *vm.EVM, method string, args ...interface{}) ([]interface{}, error) {
+		input, err := precompiles.StakingABI.Pack(method, args...)
+		suite.Require().NoError(err)
+		ret, _, err := evm.Call(vm.AccountRef(suite.address), contract.Address(), input, 1000000, uint256.NewInt(0))
+		if err != nil {
+			return nil, err
+		}
+		return precompiles.StakingABI.Unpack(method, ret)
+	}
+
+	stateDB := suite.StateDB()
+	evm := suite.precompileEVM(stateDB, contract)
+	// the funds only exist in the StateDB, the native action must see them
+	stateDB.AddBalance(suite.address, uint256.NewInt(1000))
+
+	_, err = call(evm, "delegate", valAddr.String(), big.NewInt(2000))
+	suite.Require().Error(err)
+	_, err = call(evm, "delegate", valAddr.String(), big.NewInt(600))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint256.NewInt(400), stateDB.GetBalance(suite.address))
+	// the store gas of the native action is charged to the transaction
+	suite.Require().NotZero(stateDB.NativeGasUsed())
+
+	// the value transfers to the contract are rejected
+	input, err := precompiles.StakingABI.Pack("delegation", suite.address, valAddr.String())
+	suite.Require().NoError(err)
+	_, _, err = evm.Call(vm.AccountRef(suite.address), contract.Address(), input, 1000000, uint256.NewInt(1))
+	suite.Require().Error(err)
+	suite.Require().True(stateDB.GetBalance(contract.Address()).IsZero())
+
+	res, err := call(evm, "delegation", suite.address, valAddr.String())
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(600), res[1])
+
+	res, err = call(evm, "undelegate", valAddr.String(), big.NewInt(100))
+	suite.Require().NoError(err)
+	suite.Require().Greater(res[0].(int64), suite.ctx.BlockTime().Unix())
+
+	_, err = call(evm, "delegationRewards", suite.address, valAddr.String())
+	suite.Require().NoError(err)
+
+	// the writes are rejected in a static call
+	input, err = precompiles.StakingABI.Pack("delegate", valAddr.String(), big.NewInt(1))
+	suite.Require().NoError(err)
+	_, _, err = evm.StaticCall(vm.AccountRef(suite.address), contract.Address(), input, 1000000)
+	suite.Require().ErrorIs(err, vm.ErrWriteProtection)
+
+	logs := stateDB.Logs()
+	suite.Require().Len(logs, 3)
+	suite.Require().Equal(precompiles.StakingABI.Events["Delegate"].ID, logs[0].Topics[0])
+	suite.Require().Equal(common.BytesToHash(suite.address.Bytes()), logs[0].Topics[1])
+	// the pending rewards are withdrawn when the delegation is modified
+	suite.Require().Equal(precompiles.StakingABI.Events["WithdrawRewards"].ID, logs[1].Topics[0])
+	suite.Require().Equal(precompiles.StakingABI.Events["Undelegate"].ID, logs[2].Topics[0])
+
+	suite.Require().NoError(stateDB.Commit())
+	suite.Require().Equal(sdkmath.NewInt(400), suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), bondDenom).Amount)
+	delegation, err := suite.app.StakingKeeper.GetDelegation(suite.ctx, suite.address.Bytes(), valAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.LegacyNewDec(500), delegation.Shares)
+}
+
+func (suite *KeeperTestSuite) TestBankPrecompileDenomsParam() {
+	denom := "uatom"
+	amount := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, amount))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.address.Bytes(), amount))
+
+	recipient := common.BigToAddress(big.NewInt(2))
+	contractAddr := precompiles.BankContractAddress(denom)
+	input, err := precompiles.BankABI.Pack("transfer", recipient, big.NewInt(100))
+	suite.Require().NoError(err)
+	transfer := func(gasLimit uint64) *types.MsgEthereumTxResponse {
+		nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+		msg := &core.Message{
+			From:              suite.address,
+			To:                &contractAddr,
+			Nonce:             nonce,
+			Value:             big.NewInt(0),
+			GasLimit:          gasLimit,
+			GasPrice:          big.NewInt(0),
+			GasFeeCap:         big.NewInt(0),
+			GasTipCap:         big.NewInt(0),
+			Data:              input,
+			SkipAccountChecks: true,
+		}
+		res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+		suite.Require().NoError(err)
+		return res
+	}
+	balance := func(addr common.Address) sdkmath.Int {
+		return suite.app.BankKeeper.GetBalance(suite.ctx, addr.Bytes(), denom).Amount
+	}
+
+	// the bank precompile of a denom that isn't registered doesn't exist
+	res := transfer(1000000)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Empty(res.Logs)
+	suite.Require().True(balance(recipient).IsZero())
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.BankPrecompileDenoms = []string{denom}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	// the gas required by the precompile doesn't cover the store gas of the transfer
+	intrinsicGas := uint64(21000 + 16*len(input))
+	res = transfer(intrinsicGas + precompiles.BankTransferGas + 1000)
+	suite.Require().Equal(vm.ErrOutOfGas.Error(), res.VmError)
+	suite.Require().True(balance(recipient).IsZero())
+
+	res = transfer(1000000)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Greater(res.GasUsed, intrinsicGas+precompiles.BankTransferGas+1000)
+	suite.Require().Len(res.Logs, 1)
+	suite.Require().Equal(contractAddr.Hex(), res.Logs[0].Address)
+	suite.Require().Equal(sdkmath.NewInt(100), balance(recipient))
+}
+
+func (suite *KeeperTestSuite) TestStakingPrecompileNativeGas() {
+	valAddr := sdk.ValAddress(suite.address.Bytes())
+	suite.Require().NoError(suite.app.DistrKeeper.Hooks().AfterValidatorCreated(suite.ctx, valAddr))
+	stakingParams, err := suite.app.StakingKeeper.GetParams(suite.ctx)
+	suite.Require().NoError(err)
+	stakingParams.BondDenom = suite.EvmDenom()
+	suite.Require().NoError(suite.app.StakingKeeper.SetParams(suite.ctx, stakingParams))
+	amt := sdk.NewCoins(sdk.NewInt64Coin(suite.EvmDenom(), 1000))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, amt))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.address.Bytes(), amt))
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.ActivePrecompiles = []string{precompiles.StakingContractAddress.Hex()}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	input, err := precompiles.StakingABI.Pack("delegate", valAddr.String(), big.NewInt(100))
+	suite.Require().NoError(err)
+	delegate := func(gasLimit uint64) *types.MsgEthereumTxResponse {
+		nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+		msg := &core.Message{
+			From:              suite.address,
+			To:                &precompiles.StakingContractAddress,
+			Nonce:             nonce,
+			Value:             big.NewInt(0),
+			GasLimit:          gasLimit,
+			GasPrice:          big.NewInt(0),
+			GasFeeCap:         big.NewInt(0),
+			GasTipCap:         big.NewInt(0),
+			Data:              input,
+			SkipAccountChecks: true,
+		}
+		res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+		suite.Require().NoError(err)
+		return res
+	}
+
+	// the gas required by the precompile doesn't cover the store gas of the delegation
+	intrinsicGas := uint64(21000 + 16*len(input))
+	res := delegate(intrinsicGas + precompiles.StakingDelegateGas + 1000)
+	suite.Require().Equal(vm.ErrOutOfGas.Error(), res.VmError)
+	_, err = suite.app.StakingKeeper.GetDelegation(suite.ctx, suite.address.Bytes(), valAddr)
+	suite.Require().Error(err)
+
+	res = delegate(1000000)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Greater(res.GasUsed, intrinsicGas+precompiles.StakingDelegateGas+1000)
+	delegation, err := suite.app.StakingKeeper.GetDelegation(suite.ctx, suite.address.Bytes(), valAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.LegacyNewDec(100), delegation.Shares)
+}
@@ -0,0 +1,274 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+
+	"github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+// GetScheduledCall returns the scheduled call of the given id.
+func (k Keeper) GetScheduledCall(ctx sdk.Context, id uint64) (types.ScheduledCall, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ScheduledCallKey(id))
+	if len(bz) == 0 {
+		return types.ScheduledCall{}, false
+	}
+
+	var call types.ScheduledCall
+	k.cdc.MustUnmarshal(bz, &call)
+	return call, true
+}
+
+// SetScheduledCall stores the scheduled call under its id and indexes it by next height
+// while it is enabled.
+func (k Keeper) SetScheduledCall(ctx sdk.Context, call types.ScheduledCall) {
+	store := ctx.KVStore(k.storeKey)
+	if prev, found := k.GetScheduledCall(ctx, call.Id); found {
+		store.Delete(types.ScheduledCallQueueKey(prev.NextHeight, prev.Id))
+	}
+
+	store.Set(types.ScheduledCallKey(call.Id), k.cdc.MustMarshal(&call))
+	if !call.Disabled {
+		store.Set(types.ScheduledCallQueueKey(call.NextHeight, call.Id), []byte{1})
+	}
+}
+
+// DeleteScheduledCall removes the scheduled call of the given id and its index entry.
+func (k Keeper) DeleteScheduledCall(ctx sdk.Context, id uint64) {
+	call, found := k.GetScheduledCall(ctx, id)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ScheduledCallQueueKey(call.NextHeight, id))
+	store.Delete(types.ScheduledCallKey(id))
+}
+
+// GetScheduledCalls returns all the scheduled calls ordered by id.
+func (k Keeper) GetScheduledCalls(ctx sdk.Context) []types.ScheduledCall {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduledCall)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var calls []types.ScheduledCall
+	for ; iterator.Valid(); iterator.Next() {
+		var call types.ScheduledCall
+		k.cdc.MustUnmarshal(iterator.Value(), &call)
+		calls = append(calls, call)
+	}
+	return calls
+}
+
+// GetNextScheduledCallID returns the id of the next registered scheduled call.
+func (k Keeper) GetNextScheduledCallID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyScheduledCallID)
+	if len(bz) == 0 {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextScheduledCallID sets the id of the next registered scheduled call.
+func (k Keeper) SetNextScheduledCallID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyScheduledCallID, sdk.Uint64ToBigEndian(id))
+}
+
+// getDueScheduledCalls returns the enabled scheduled calls due at the given height, ordered
+// by next height and id, whose gas limits fit in the scheduled calls gas limit. The calls
+// exceeding it on their own are returned too, to be failed instead of blocking the queue.
+func (k Keeper) getDueScheduledCalls(ctx sdk.Context, height, gasLimit uint64) []types.ScheduledCall {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduledCallQueue)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(height+1))
+	defer iterator.Close()
+
+	var (
+		calls   []types.ScheduledCall
+		gasUsed uint64
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		call, found := k.GetScheduledCall(ctx, sdk.BigEndianToUint64(iterator.Key()[8:]))
+		if !found || !call.Due(height) {
+			continue
+		}
+		if call.GasLimit <= gasLimit {
+			// the remaining calls are deferred in order to the next blocks
+			if gasUsed+call.GasLimit > gasLimit {
+				break
+			}
+			gasUsed += call.GasLimit
+		}
+		calls = append(calls, call)
+	}
+	return calls
+}
+
+// ExecuteScheduledCalls executes the enabled scheduled calls due at the current height
+// from the EVM address of the authority, up to the scheduled calls gas limit of the
+// params, the remaining due calls are deferred to the next blocks. Each execution is
+// charged to the payer of the call at the block base fee, within its allowance, and
+// emitted as a `scheduled_call` event. A failed execution discards its state changes,
+// and the call is disabled after max failures consecutive failed executions.
+func (k *Keeper) ExecuteScheduledCalls(ctx sdk.Context) {
+	// #nosec G115 block height always positive
+	height := uint64(ctx.BlockHeight())
+	params := k.GetParams(ctx)
+	if params.ScheduledCallsGasLimit == 0 {
+		return
+	}
+
+	due := k.getDueScheduledCalls(ctx, height, params.ScheduledCallsGasLimit)
+	if len(due) == 0 {
+		return
+	}
+
+	baseFee := k.GetBaseFee(ctx, params.ChainConfig.EthereumConfig(k.eip155ChainID))
+
+	for _, call := range due {
+		var (
+			res  *types.MsgEthereumTxResponse
+			fees sdk.Coins
+			err  error
+		)
+		if call.GasLimit > params.ScheduledCallsGasLimit {
+			err = errorsmod.Wrapf(
+				types.ErrInvalidGasLimit,
+				"gas limit %d exceeds the scheduled calls gas limit %d", call.GasLimit, params.ScheduledCallsGasLimit,
+			)
+		} else {
+			res, fees, err = k.executeScheduledCall(ctx, &call, params.EvmDenom, baseFee)
+		}
+
+		if err != nil {
+			call.Failures++
+			if call.Failures >= call.MaxFailures {
+				call.Disabled = true
+			}
+			k.Logger(ctx).Info("scheduled call failed", "id", call.Id, "failures", call.Failures, "error", err.Error())
+		} else {
+			call.Failures = 0
+		}
+		call.NextHeight = height + call.Interval
+		k.SetScheduledCall(ctx, call)
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyScheduledCallID, strconv.FormatUint(call.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, call.Contract),
+			sdk.NewAttribute(types.AttributeKeyScheduledCallDisabled, strconv.FormatBool(call.Disabled)),
+		}
+		if res != nil {
+			attrs = append(attrs,
+				sdk.NewAttribute(types.AttributeKeyTxGasUsed, strconv.FormatUint(res.GasUsed, 10)),
+				sdk.NewAttribute(types.AttributeKeyScheduledCallFee, fees.String()),
+			)
+		}
+		if err != nil {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyScheduledCallError, err.Error()))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeScheduledCall, attrs...))
+	}
+}
+
+// executeScheduledCall executes the call and charges the gas used to its payer in a cached
+// context, which is only written if both succeed. The charged fees are deducted from the
+// allowance of the call.
+func (k *Keeper) executeScheduledCall(
+	ctx sdk.Context,
+	call *types.ScheduledCall,
+	denom string,
+	baseFee *big.Int,
+) (res *types.MsgEthereumTxResponse, fees sdk.Coins, err error) {
+	// a panicking call must not halt the chain
+	defer func() {
+		if r := recover(); r != nil {
+			res, fees, err = nil, nil, fmt.Errorf("scheduled call panicked: %v", r)
+		}
+	}()
+
+	cacheCtx, write := ctx.CacheContext()
+
+	contract := common.HexToAddress(call.Contract)
+	res, err = k.CallEVMWithData(cacheCtx, common.BytesToAddress(k.authority), &contract, call.Data, call.GasLimit, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	if res.Failed() {
+		return res, nil, revertError(nil, res)
+	}
+
+	fees, err = k.chargeScheduledCall(cacheCtx, *call, res.GasUsed, denom, baseFee)
+	if err != nil {
+		return res, nil, err
+	}
+
+	write()
+	call.Allowance = call.Allowance.Sub(fees.AmountOf(denom))
+	return res, fees, nil
+}
+
+// chargeScheduledCall sends the fees of the gas used at the base fee from the payer to the
+// fee collector, burning them if the fee market burns the base fee. It fails if the fees
+// exceed the allowance approved by the payer.
+func (k *Keeper) chargeScheduledCall(
+	ctx sdk.Context,
+	call types.ScheduledCall,
+	gasUsed uint64,
+	denom string,
+	baseFee *big.Int,
+) (sdk.Coins, error) {
+	if baseFee == nil {
+		return sdk.Coins{}, nil
+	}
+
+	amount := new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), baseFee)
+	if amount.Sign() <= 0 {
+		return sdk.Coins{}, nil
+	}
+	fees := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount))}
+	if fees[0].Amount.GT(call.Allowance) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInsufficientScheduledCallAllowance,
+			"fees %s exceed the allowance %s%s of the payer %s", fees, call.Allowance, denom, call.Payer,
+		)
+	}
+
+	payer, err := sdk.AccAddressFromBech32(call.Payer)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, authtypes.FeeCollectorName, fees); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to charge the fees %s to the payer %s", fees, call.Payer)
+	}
+
+	if k.feeMarketKeeper.GetParams(ctx).BurnBaseFee {
+		msg := &core.Message{GasPrice: baseFee}
+		if err := k.BurnBaseFee(ctx, msg, gasUsed, baseFee, denom); err != nil {
+			return nil, err
+		}
+	}
+	return fees, nil
+}
@@ -0,0 +1,283 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/ethermint/server/config"
+	"github.com/zeta-chain/ethermint/tests"
+	"github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestScheduledCalls() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	erc20 := types.ERC20Contract
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	govAddr := common.BytesToAddress(authority)
+	gasLimit := uint64(config.DefaultGasCap)
+
+	suite.setScheduledCallBaseFee()
+
+	contract, err := k.DeployEVMContract(suite.ctx, govAddr, erc20.ABI, erc20.Bin, gasLimit, govAddr, big.NewInt(1000))
+	suite.Require().NoError(err)
+
+	payer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.EvmDenom(), 1_000_000_000_000_000))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, payer, coins))
+
+	recipient := tests.GenerateAddress()
+	transfer := func(amount int64) []byte {
+		data, err := erc20.ABI.Pack("transfer", recipient, big.NewInt(amount))
+		suite.Require().NoError(err)
+		return data
+	}
+	balanceOf := func() *big.Int {
+		values, err := k.CallEVM(suite.ctx, govAddr, contract, erc20.ABI, "balanceOf", gasLimit, false, recipient)
+		suite.Require().NoError(err)
+		return values[0].(*big.Int)
+	}
+
+	msg := &types.MsgRegisterScheduledCall{
+		Authority:   authority.String(),
+		Contract:    contract.Hex(),
+		Data:        transfer(100),
+		GasLimit:    100_000,
+		Payer:       payer.String(),
+		Interval:    2,
+		MaxFailures: 2,
+	}
+
+	// only the authority can register calls, within the block gas limit
+	_, err = k.RegisterScheduledCall(suite.ctx, &types.MsgRegisterScheduledCall{Authority: suite.address.String()})
+	suite.Require().Error(err)
+	invalidMsg := *msg
+	invalidMsg.GasLimit = 10_000_001
+	_, err = k.RegisterScheduledCall(suite.ctx.WithBlockGasMeter(storetypes.NewGasMeter(10_000_000)), &invalidMsg)
+	suite.Require().ErrorIs(err, types.ErrInvalidGasLimit)
+
+	height := suite.ctx.BlockHeight()
+	res, err := k.RegisterScheduledCall(suite.ctx, msg)
+	suite.Require().NoError(err)
+	transferID := res.Id
+
+	// the failing call is disabled after two consecutive reverts
+	msg.Data = transfer(10_000)
+	msg.Interval = 1
+	res, err = k.RegisterScheduledCall(suite.ctx, msg)
+	suite.Require().NoError(err)
+	revertID := res.Id
+	suite.Require().Equal(transferID+1, revertID)
+
+	queryRes, err := k.ScheduledCalls(suite.ctx, &types.QueryScheduledCallsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(queryRes.Calls, 2)
+
+	// only the payer can approve the fees of its calls
+	allowance := sdkmath.NewInt(1_000_000_000_000_000)
+	_, err = k.ApproveScheduledCall(suite.ctx, &types.MsgApproveScheduledCall{Payer: suite.address.String(), Id: transferID, Allowance: allowance})
+	suite.Require().ErrorIs(err, errortypes.ErrUnauthorized)
+	_, err = k.ApproveScheduledCall(suite.ctx, &types.MsgApproveScheduledCall{Payer: payer.String(), Id: 100, Allowance: allowance})
+	suite.Require().ErrorIs(err, types.ErrScheduledCallNotFound)
+	for _, id := range []uint64{transferID, revertID} {
+		_, err = k.ApproveScheduledCall(suite.ctx, &types.MsgApproveScheduledCall{Payer: payer.String(), Id: id, Allowance: allowance})
+		suite.Require().NoError(err)
+	}
+
+	endBlock := func(h int64) sdk.Events {
+		ctx := suite.ctx.WithBlockHeight(h).WithEventManager(sdk.NewEventManager())
+		suite.Require().NoError(k.EndBlock(ctx))
+		var events sdk.Events
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeScheduledCall {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+
+	// not due at the registration height
+	suite.Require().Empty(endBlock(height))
+	suite.Require().Equal(int64(0), balanceOf().Int64())
+
+	// the payer is charged for the gas used within its allowance
+	payerBalance := suite.app.BankKeeper.GetBalance(suite.ctx, payer, suite.EvmDenom())
+	suite.Require().Len(endBlock(height+1), 2)
+	suite.Require().Equal(int64(100), balanceOf().Int64())
+	charged := payerBalance.Sub(suite.app.BankKeeper.GetBalance(suite.ctx, payer, suite.EvmDenom())).Amount
+	suite.Require().True(charged.IsPositive())
+
+	call, found := k.GetScheduledCall(suite.ctx, transferID)
+	suite.Require().True(found)
+	suite.Require().Equal(allowance.Sub(charged), call.Allowance)
+
+	call, found = k.GetScheduledCall(suite.ctx, revertID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), call.Failures)
+	suite.Require().False(call.Disabled)
+	suite.Require().Equal(allowance, call.Allowance)
+
+	events := endBlock(height + 2)
+	suite.Require().Len(events, 1)
+	call, _ = k.GetScheduledCall(suite.ctx, revertID)
+	suite.Require().Equal(uint64(2), call.Failures)
+	suite.Require().True(call.Disabled)
+	// the disabled call is removed from the execution queue
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	suite.Require().False(store.Has(types.ScheduledCallQueueKey(call.NextHeight, revertID)))
+
+	// the transfer runs every two blocks and the disabled call no longer runs
+	events = endBlock(height + 3)
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(int64(200), balanceOf().Int64())
+	call, _ = k.GetScheduledCall(suite.ctx, transferID)
+	suite.Require().Equal(uint64(height+5), call.NextHeight)
+	suite.Require().Zero(call.Failures)
+
+	// cancel the calls
+	_, err = k.CancelScheduledCall(suite.ctx, &types.MsgCancelScheduledCall{Authority: authority.String(), Id: 100})
+	suite.Require().ErrorIs(err, types.ErrScheduledCallNotFound)
+	_, err = k.CancelScheduledCall(suite.ctx, &types.MsgCancelScheduledCall{Authority: authority.String(), Id: transferID})
+	suite.Require().NoError(err)
+	_, found = k.GetScheduledCall(suite.ctx, transferID)
+	suite.Require().False(found)
+	suite.Require().False(store.Has(types.ScheduledCallQueueKey(uint64(height+5), transferID)))
+	suite.Require().Empty(endBlock(height + 5))
+	suite.Require().Equal(int64(200), balanceOf().Int64())
+}
+
+func (suite *KeeperTestSuite) TestScheduledCallPayerAllowance() {
+	testCases := []struct {
+		name      string
+		funded    bool
+		allowance sdkmath.Int
+	}{
+		{"funded payer without allowance", true, sdkmath.ZeroInt()},
+		{"funded payer with an insufficient allowance", true, sdkmath.NewInt(1_000_000_000)},
+		{"unfunded payer", false, sdkmath.NewInt(1_000_000_000_000_000)},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			k := suite.app.EvmKeeper
+			authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+			suite.setScheduledCallBaseFee()
+
+			payer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+			if tc.funded {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(suite.EvmDenom(), 1_000_000_000_000_000))
+				suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+				suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, payer, coins))
+			}
+			payerBalance := suite.app.BankKeeper.GetBalance(suite.ctx, payer, suite.EvmDenom())
+
+			// a call the payer can't or didn't approve to pay fails without executing
+			res, err := k.RegisterScheduledCall(suite.ctx, &types.MsgRegisterScheduledCall{
+				Authority:   authority.String(),
+				Contract:    tests.GenerateAddress().Hex(),
+				GasLimit:    100_000,
+				Payer:       payer.String(),
+				Interval:    1,
+				MaxFailures: 2,
+			})
+			suite.Require().NoError(err)
+			_, err = k.ApproveScheduledCall(suite.ctx, &types.MsgApproveScheduledCall{Payer: payer.String(), Id: res.Id, Allowance: tc.allowance})
+			suite.Require().NoError(err)
+
+			govAddr := common.BytesToAddress(authority)
+			nonce := k.GetNonce(suite.ctx, govAddr)
+			ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+			suite.Require().NoError(k.EndBlock(ctx))
+
+			call, found := k.GetScheduledCall(suite.ctx, res.Id)
+			suite.Require().True(found)
+			suite.Require().Equal(uint64(1), call.Failures)
+			suite.Require().False(call.Disabled)
+			suite.Require().Equal(tc.allowance, call.Allowance)
+			suite.Require().Equal(nonce, k.GetNonce(suite.ctx, govAddr))
+			suite.Require().Equal(payerBalance, suite.app.BankKeeper.GetBalance(suite.ctx, payer, suite.EvmDenom()))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestScheduledCallsGasLimit() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	params := k.GetParams(suite.ctx)
+	params.ScheduledCallsGasLimit = 250_000
+	suite.Require().NoError(k.SetParams(suite.ctx, params))
+
+	msg := &types.MsgRegisterScheduledCall{
+		Authority:   authority.String(),
+		Contract:    tests.GenerateAddress().Hex(),
+		GasLimit:    250_001,
+		Payer:       sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+		Interval:    1,
+		MaxFailures: 2,
+	}
+
+	// the gas limit of a call can't exceed the scheduled calls gas limit
+	_, err := k.RegisterScheduledCall(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidGasLimit)
+
+	msg.GasLimit = 100_000
+	var ids []uint64
+	for i := 0; i < 3; i++ {
+		res, err := k.RegisterScheduledCall(suite.ctx, msg)
+		suite.Require().NoError(err)
+		ids = append(ids, res.Id)
+	}
+
+	height := suite.ctx.BlockHeight()
+	endBlock := func(h int64) []uint64 {
+		ctx := suite.ctx.WithBlockHeight(h).WithEventManager(sdk.NewEventManager())
+		suite.Require().NoError(k.EndBlock(ctx))
+		var executed []uint64
+		for _, call := range k.GetScheduledCalls(suite.ctx) {
+			if call.NextHeight == uint64(h)+1 {
+				executed = append(executed, call.Id)
+			}
+		}
+		return executed
+	}
+
+	// the last call is deferred to the next block, where it runs first
+	suite.Require().Equal(ids[:2], endBlock(height+1))
+	call, _ := k.GetScheduledCall(suite.ctx, ids[2])
+	suite.Require().Equal(uint64(height+1), call.NextHeight)
+	suite.Require().Equal([]uint64{ids[0], ids[2]}, endBlock(height+2))
+
+	// a call exceeding a lowered gas limit fails instead of blocking the queue
+	params.ScheduledCallsGasLimit = 50_000
+	suite.Require().NoError(k.SetParams(suite.ctx, params))
+	suite.Require().Len(endBlock(height+3), 3)
+	for _, id := range ids {
+		call, _ := k.GetScheduledCall(suite.ctx, id)
+		suite.Require().Equal(uint64(1), call.Failures)
+	}
+
+	// a zero gas limit disables the execution
+	params.ScheduledCallsGasLimit = 0
+	suite.Require().NoError(k.SetParams(suite.ctx, params))
+	suite.Require().Empty(endBlock(height + 4))
+}
+
+// setScheduledCallBaseFee enables a non-zero base fee charged to the scheduled call payers.
+func (suite *KeeperTestSuite) setScheduledCallBaseFee() {
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.NoBaseFee = false
+	params.BaseFee = sdkmath.NewInt(1_000_000_000)
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+}
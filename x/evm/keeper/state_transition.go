@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"slices"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -61,7 +62,7 @@ func (k *Keeper) NewEVM(
 		Transfer:    core.Transfer,
 		GetHash:     k.GetHashFn(ctx),
 		Coinbase:    cfg.CoinBase,
-		GasLimit:    ethermint.BlockGasLimit(ctx),
+		GasLimit:    cfg.Params.EVMBlockGasLimit(ethermint.BlockGasLimit(ctx)),
 		BlockNumber: big.NewInt(ctx.BlockHeight()),
 		// #nosec G115 timestamp always positive
 		Time:       uint64(ctx.BlockHeader().Time.Unix()),
@@ -69,6 +70,7 @@ func (k *Keeper) NewEVM(
 		BaseFee:    cfg.BaseFee,
 		Random:     nil, // not supported
 	}
+	cfg.BlockOverrides.Apply(&blockCtx)
 
 	txCtx := core.NewEVMTxContext(msg)
 	if tracer == nil {
@@ -79,17 +81,36 @@ func (k *Keeper) NewEVM(
 
 	// rules are used to determine the active precompiles for the current block height.
 	// i.e rules.IsByzantium, rules.IsConstantinople, etc.
-	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil, 1)
+	rules := cfg.ChainConfig.Rules(blockCtx.BlockNumber, cfg.ChainConfig.MergeNetsplitBlock != nil, blockCtx.Time)
 
-	statefulPrecompiles := make([]vm.StatefulPrecompiledContract, 0)
+	var statefulPrecompiles []vm.StatefulPrecompiledContract
 
-	// Add the custom stateful precompiled contracts and their addresses to the list.
-	// Then, mark them as active.
+	// the block hash history contract is activated with the Prague fork, like EIP-2935
+	if rules.IsPrague {
+		statefulPrecompiles = append(statefulPrecompiles, BlockHashContract{})
+	}
+
+	activePrecompiles := cfg.Params.ActivePrecompileAddresses()
+
+	// Add the custom stateful precompiled contracts that are active in the params to the list.
 	for _, fn := range k.customContractFns {
 		c := fn(ctx, rules)
+		if !slices.Contains(activePrecompiles, c.Address()) {
+			continue
+		}
 		statefulPrecompiles = append(statefulPrecompiles, c)
 	}
 
+	// Add the stateful precompiled contracts enabled by the params.
+	for _, fn := range k.paramsContractsFns {
+		statefulPrecompiles = append(statefulPrecompiles, fn(ctx, rules, cfg.Params)...)
+	}
+
+	// the calls to the blocked addresses run a contract rejecting them instead of their code
+	for _, blocked := range cfg.Params.BlockedAddresses {
+		statefulPrecompiles = append(statefulPrecompiles, blockedAddressContract{address: common.HexToAddress(blocked)})
+	}
+
 	evm := vm.NewEVM(blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
 
 	// set precompiled contracts
@@ -114,26 +135,16 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 		case ctx.BlockHeight() == h:
 			// Case 1: The requested height matches the one from the context so we can retrieve the header
 			// hash directly from the context.
-			// Note: The headerHash is only set at begin block, it will be nil in case of a query context
-			headerHash := ctx.HeaderHash()
-			if len(headerHash) != 0 {
-				return common.BytesToHash(headerHash)
-			}
-
-			// only recompute the hash if not set (eg: checkTxState)
-			contextBlockHeader := ctx.BlockHeader()
-			header, err := tmtypes.HeaderFromProto(&contextBlockHeader)
-			if err != nil {
-				k.Logger(ctx).Error("failed to cast tendermint header from proto", "error", err)
-				return common.Hash{}
-			}
-
-			headerHash = header.Hash()
-			return common.BytesToHash(headerHash)
+			return k.headerHash(ctx)
 
 		case ctx.BlockHeight() > h:
 			// Case 2: if the chain is not the current height we need to retrieve the hash from the store for the
 			// current chain epoch. This only applies if the current height is greater than the requested height.
+			// The block hash history is used first, it isn't affected by the pruning of the historical info.
+			if hash, found := k.GetBlockHash(ctx, height); found {
+				return hash
+			}
+
 			histInfo, err := k.stakingKeeper.GetHistoricalInfo(ctx, h)
 			if err != nil {
 				k.Logger(ctx).Debug("historical info not found", "height", h)
@@ -154,6 +165,25 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 	}
 }
 
+// headerHash returns the hash of the current block header.
+func (k Keeper) headerHash(ctx sdk.Context) common.Hash {
+	// Note: The headerHash is only set at begin block, it will be nil in case of a query context
+	headerHash := ctx.HeaderHash()
+	if len(headerHash) != 0 {
+		return common.BytesToHash(headerHash)
+	}
+
+	// only recompute the hash if not set (eg: checkTxState)
+	contextBlockHeader := ctx.BlockHeader()
+	header, err := tmtypes.HeaderFromProto(&contextBlockHeader)
+	if err != nil {
+		k.Logger(ctx).Error("failed to cast tendermint header from proto", "error", err)
+		return common.Hash{}
+	}
+
+	return common.BytesToHash(header.Hash())
+}
+
 // ApplyTransaction runs and attempts to perform a state transition with the given transaction (i.e Message), that will
 // only be persisted (committed) to the underlying KVStore if the transaction does not fail.
 //
@@ -186,7 +216,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 	txConfig := k.TxConfig(ctx, ethTx.Hash())
 
 	// get the signer according to the chain rules from the config and block height
-	signer := ethermint.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	signer := ethermint.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()), ethermint.BlockTime(ctx))
 	msg, err := msgEth.AsMessage(signer, cfg.BaseFee)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
@@ -203,12 +233,28 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		tmpCtx, commit = ctx.CacheContext()
 	}
 
+	// the pre processing hooks can modify or reject the message
+	if err = k.PreTxProcessing(tmpCtx, msg); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to execute pre processing")
+	}
+
+	// use the node-level native tracer if configured, otherwise the default one
+	var tracer vm.EVMLogger
+	nativeTracer := k.nativeTracer(ctx, txConfig)
+	if nativeTracer != nil {
+		tracer = nativeTracer
+	}
+
 	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
+	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, tracer, true, cfg, txConfig)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
 	}
 
+	if nativeTracer != nil {
+		k.writeTrace(ctx, txConfig.TxHash, nativeTracer)
+	}
+
 	logs := types.LogsToEthereum(res.Logs)
 
 	// Compute block bloom filter
@@ -264,9 +310,30 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		}
 	}
 
-	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
-	if err = k.RefundGas(ctx, msg, msg.GasLimit-res.GasUsed, cfg.Params.EvmDenom); err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From)
+	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one,
+	// in the denom the fee has been paid with and to the account which paid it.
+	refundMsg := msg
+	if payer, ok := k.GetFeePayerTransient(ctx, txConfig.TxHash); ok {
+		payerMsg := *msg
+		payerMsg.From = payer
+		refundMsg = &payerMsg
+	}
+	feePayment, altFee := k.GetFeePaymentTransient(ctx, txConfig.TxHash)
+	if altFee {
+		err = k.RefundGasInFeeDenom(ctx, refundMsg, msg.GasLimit-res.GasUsed, feePayment)
+	} else {
+		err = k.RefundGas(ctx, refundMsg, msg.GasLimit-res.GasUsed, cfg.Params.EvmDenom)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", refundMsg.From)
+	}
+
+	// burn the base fee of the gas used, EIP-1559 style. The fees paid in an alternative
+	// denom are left to the fee collector.
+	if cfg.BaseFee != nil && !altFee && k.feeMarketKeeper.GetParams(ctx).BurnBaseFee {
+		if err = k.BurnBaseFee(ctx, msg, res.GasUsed, cfg.BaseFee, cfg.Params.EvmDenom); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to burn the base fee of the tx %s", txConfig.TxHash)
+		}
 	}
 
 	if len(receipt.Logs) > 0 {
@@ -282,6 +349,10 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		return nil, errorsmod.Wrap(err, "failed to add transient gas used")
 	}
 
+	if _, err = k.AddBlockGasUsedTransient(ctx, res.GasUsed); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to add block gas used")
+	}
+
 	// reset the gas meter for current cosmos transaction
 	k.ResetGasMeterAndConsumeGas(ctx, totalGasUsed)
 	return res, nil
@@ -355,9 +426,33 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
+	if msg.To == nil && !cfg.Params.IsCreateAllowed(msg.From) {
+		return nil, errorsmod.Wrapf(types.ErrCreateNotAllowed, "address %s", msg.From.Hex())
+	}
+
+	if cfg.Params.IsBlockedAddress(msg.From) {
+		return nil, errorsmod.Wrapf(types.ErrBlockedAddress, "address %s", msg.From.Hex())
+	} else if msg.To != nil && cfg.Params.IsBlockedAddress(*msg.To) {
+		return nil, errorsmod.Wrapf(types.ErrBlockedAddress, "address %s", msg.To.Hex())
+	}
+
 	stateDB := statedb.New(ctx, k, txConfig)
+	if err := cfg.StateOverrides.Apply(stateDB); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to apply state overrides")
+	}
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
+	// the contract creations from inside contracts are checked against the create
+	// allowlist, a call to an account without code runs no nested call frame
+	runsCode := msg.To == nil || stateDB.GetCodeSize(*msg.To) > 0
+	var guard *callGuard
+	if len(cfg.Params.CreateAllowlist) > 0 && runsCode {
+		guard = newCallGuard(evm.Config.Tracer, func(addr common.Address) bool {
+			return k.isCreateAllowed(ctx, cfg.Params, addr)
+		})
+		evm.Config.Tracer = guard
+	}
+
 	leftoverGas := msg.GasLimit
 
 	// Allow the tracer captures the tx level events, mainly the gas consumption.
@@ -386,9 +481,14 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 	}
 	leftoverGas -= intrinsicGas
 
-	// #nosec G115 timestamp always positive
-	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil, uint64(ctx.BlockTime().Unix()))
-	stateDB.Prepare(rules, msg.From, cfg.CoinBase, msg.To, evm.AllPrecompiledAddresses(rules), msg.AccessList)
+	rules := cfg.ChainConfig.Rules(evm.Context.BlockNumber, cfg.ChainConfig.MergeNetsplitBlock != nil, evm.Context.Time)
+
+	stateDB.Prepare(rules, msg.From, cfg.CoinBase, msg.To, precompiledAddresses(evm, rules, cfg.Params), msg.AccessList)
+
+	var blocked []blockedBalance
+	if runsCode {
+		blocked = blockedBalances(stateDB, cfg.Params)
+	}
 
 	value := msg.Value
 	if msg.Value == nil {
@@ -399,6 +499,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		return nil, fmt.Errorf("%v is not a valid uint256", value)
 	}
 
+	snapshot := stateDB.Snapshot()
 	if contractCreation {
 		// take over the nonce management from evm:
 		// - reset sender's nonce to msg.Nonce() before calling evm.
@@ -410,6 +511,36 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		ret, leftoverGas, vmErr = evm.Call(sender, *msg.To, msg.Data, leftoverGas, valueUint256)
 	}
 
+	// a call to a blocked address or a call frame rejected by the guard fails the whole
+	// transaction
+	abortErr := stateDB.AbortErr()
+	if abortErr == nil && guard != nil {
+		abortErr = guard.Err()
+	}
+	if abortErr == nil {
+		abortErr = checkBlockedBalances(stateDB, blocked)
+	}
+	if abortErr != nil {
+		// discard the whole execution, the sender nonce is still increased for a contract creation
+		stateDB.RevertToSnapshot(snapshot)
+		if contractCreation {
+			stateDB.SetNonce(sender.Address(), msg.Nonce+1)
+		}
+		ret, vmErr = nil, abortErr
+	}
+
+	// charge the store gas consumed by the native actions of the stateful precompiles,
+	// the whole execution fails if the gas left doesn't cover it
+	if nativeGas := stateDB.NativeGasUsed(); nativeGas > leftoverGas {
+		stateDB.RevertToSnapshot(snapshot)
+		if contractCreation {
+			stateDB.SetNonce(sender.Address(), msg.Nonce+1)
+		}
+		ret, vmErr, leftoverGas = nil, vm.ErrOutOfGas, 0
+	} else {
+		leftoverGas -= nativeGas
+	}
+
 	refundQuotient := params.RefundQuotient
 
 	// After EIP-3529: refunds are capped to gasUsed / 5
@@ -467,3 +598,13 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		Hash:    txConfig.TxHash.Hex(),
 	}, nil
 }
+
+// precompiledAddresses returns the addresses of the precompiled contracts warmed in the
+// access list, excluding the blocked addresses that are only intercepted as precompiles.
+func precompiledAddresses(evm *vm.EVM, rules params.Rules, evmParams types.Params) []common.Address {
+	addresses := evm.AllPrecompiledAddresses(rules)
+	if len(evmParams.BlockedAddresses) == 0 {
+		return addresses
+	}
+	return slices.DeleteFunc(addresses, evmParams.IsBlockedAddress)
+}
@@ -146,7 +146,7 @@ func newNativeMessage(
 	data []byte,
 	accessList ethtypes.AccessList,
 ) (*core.Message, error) {
-	msgSigner := ethermint.MakeSigner(cfg, big.NewInt(blockHeight))
+	msgSigner := ethermint.MakeSigner(cfg, big.NewInt(blockHeight), 0)
 
 	msg, baseFee, err := newEthMsgTx(nonce, blockHeight, address, cfg, krSigner, ethSigner, txType, data, accessList)
 	if err != nil {
@@ -5,17 +5,21 @@ import (
 	"math"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
 	"github.com/zeta-chain/ethermint/tests"
 	ethermint "github.com/zeta-chain/ethermint/types"
 	"github.com/zeta-chain/ethermint/x/evm/keeper"
@@ -62,7 +66,7 @@ func (suite *KeeperTestSuite) TestGetHashFn() {
 		},
 		{
 			"case 2.1: height lower than current one, hist info not found",
-			1,
+			2,
 			func() {
 				suite.ctx = suite.ctx.WithBlockHeight(10)
 			},
@@ -70,25 +74,47 @@ func (suite *KeeperTestSuite) TestGetHashFn() {
 		},
 		{
 			"case 2.2: height lower than current one, invalid hist info header",
-			1,
+			2,
 			func() {
-				suite.app.StakingKeeper.SetHistoricalInfo(suite.ctx, 1, &stakingtypes.HistoricalInfo{})
+				suite.app.StakingKeeper.SetHistoricalInfo(suite.ctx, 2, &stakingtypes.HistoricalInfo{})
 				suite.ctx = suite.ctx.WithBlockHeight(10)
 			},
 			common.Hash{},
 		},
 		{
 			"case 2.3: height lower than current one, calculated from hist info header",
-			1,
+			2,
 			func() {
 				histInfo := &stakingtypes.HistoricalInfo{
 					Header: header,
 				}
-				suite.app.StakingKeeper.SetHistoricalInfo(suite.ctx, 1, histInfo)
+				suite.app.StakingKeeper.SetHistoricalInfo(suite.ctx, 2, histInfo)
 				suite.ctx = suite.ctx.WithBlockHeight(10)
 			},
 			common.BytesToHash(hash),
 		},
+		{
+			"case 2.4: height lower than current one, found in the block hash history",
+			2,
+			func() {
+				histInfo := &stakingtypes.HistoricalInfo{
+					Header: header,
+				}
+				suite.app.StakingKeeper.SetHistoricalInfo(suite.ctx, 2, histInfo)
+				suite.app.EvmKeeper.SetBlockHash(suite.ctx, 2, common.BytesToHash([]byte("block 2")))
+				suite.ctx = suite.ctx.WithBlockHeight(10)
+			},
+			common.BytesToHash([]byte("block 2")),
+		},
+		{
+			"case 2.5: height out of the block hash history window",
+			2,
+			func() {
+				suite.app.EvmKeeper.SetBlockHash(suite.ctx, 2, common.BytesToHash([]byte("block 2")))
+				suite.ctx = suite.ctx.WithBlockHeight(2 + types.BlockHashHistorySize + 1)
+			},
+			common.Hash{},
+		},
 		{
 			"case 3: height greater than current one",
 			200,
@@ -458,6 +484,55 @@ func (suite *KeeperTestSuite) TestRefundGas() {
 	suite.mintFeeCollector = false
 }
 
+func (suite *KeeperTestSuite) TestBurnBaseFee() {
+	testCases := []struct {
+		name      string
+		gasPrice  *big.Int
+		baseFee   *big.Int
+		expBurned *big.Int
+	}{
+		{
+			"burn the base fee, keep the tip",
+			big.NewInt(10),
+			big.NewInt(7),
+			big.NewInt(7 * 21000),
+		},
+		{
+			"gas price lower than the base fee",
+			big.NewInt(5),
+			big.NewInt(7),
+			big.NewInt(5 * 21000),
+		},
+		{
+			"zero base fee",
+			big.NewInt(10),
+			big.NewInt(0),
+			big.NewInt(0),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+
+			denom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+			feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denom).Amount
+			supplyBefore := suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount
+
+			msg := &core.Message{From: suite.address, GasPrice: tc.gasPrice}
+			err := suite.app.EvmKeeper.BurnBaseFee(suite.ctx, msg, params.TxGas, tc.baseFee, denom)
+			suite.Require().NoError(err)
+
+			burned := sdkmath.NewIntFromBigInt(tc.expBurned)
+			suite.Require().Equal(balanceBefore.Sub(burned), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denom).Amount)
+			suite.Require().Equal(supplyBefore.Sub(burned), suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount)
+			suite.Require().Equal(tc.expBurned, suite.app.FeeMarketKeeper.GetTransientBurnedBaseFee(suite.ctx))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	testCases := []struct {
 		name        string
@@ -521,7 +596,7 @@ func (suite *KeeperTestSuite) TestEVMConfig() {
 	proposerAddress := suite.ctx.BlockHeader().ProposerAddress
 	cfg, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, big.NewInt(9000))
 	suite.Require().NoError(err)
-	suite.Require().Equal(types.DefaultParams(), cfg.Params)
+	suite.Require().Equal(suite.DefaultParams(), cfg.Params)
 	// london hardfork is enabled by default
 	suite.Require().Equal(big.NewInt(0), cfg.BaseFee)
 	suite.Require().Equal(suite.address, cfg.CoinBase)
@@ -632,6 +707,15 @@ func (suite *KeeperTestSuite) TestApplyMessageWithConfig() {
 			},
 			true,
 		},
+		{
+			"create contract tx from an address not in the create allowlist",
+			func() {
+				msg, err = suite.createContractGethMsg(vmdb.GetNonce(suite.address), signer, chainCfg, big.NewInt(1))
+				suite.Require().NoError(err)
+				config.Params.CreateAllowlist = []string{common.BigToAddress(big.NewInt(1)).Hex()}
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -664,13 +748,137 @@ func (suite *KeeperTestSuite) TestApplyMessageWithConfig() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCreateAllowlistFactory() {
+	// factory creating an empty contract when called: CREATE(0, 0, 0)
+	factory := common.BigToAddress(big.NewInt(0x1234))
+	factoryCode := common.FromHex("0x600060006000f05000")
+
+	testCases := []struct {
+		name          string
+		allowlist     []string
+		createFactory bool
+		expErr        error
+	}{
+		{"empty allowlist", nil, false, nil},
+		{"factory in the allowlist", []string{suite.address.Hex(), factory.Hex()}, false, nil},
+		{"factory managed by the admin", []string{suite.address.Hex()}, true, nil},
+		{"factory not in the allowlist", []string{suite.address.Hex()}, false, types.ErrCreateNotAllowed},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			vmdb := suite.StateDB()
+			vmdb.SetCode(factory, factoryCode)
+			suite.Require().NoError(vmdb.Commit())
+			if tc.createFactory {
+				suite.app.EvmKeeper.SetCreateFactory(suite.ctx, factory)
+			}
+
+			proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+			config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, big.NewInt(9000))
+			suite.Require().NoError(err)
+			config.Params.CreateAllowlist = tc.allowlist
+
+			msg := &core.Message{
+				From:      suite.address,
+				To:        &factory,
+				Nonce:     suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+				Value:     big.NewInt(0),
+				GasLimit:  100000,
+				GasPrice:  big.NewInt(0),
+				GasFeeCap: big.NewInt(0),
+				GasTipCap: big.NewInt(0),
+			}
+			txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+			res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+			suite.Require().NoError(err)
+
+			if tc.expErr != nil {
+				suite.Require().True(res.Failed())
+				suite.Require().Contains(res.VmError, tc.expErr.Error())
+				// the factory state is reverted
+				suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetNonce(suite.ctx, factory))
+				return
+			}
+			suite.Require().False(res.Failed())
+			suite.Require().Equal(uint64(1), suite.app.EvmKeeper.GetNonce(suite.ctx, factory))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBlockedAddresses() {
+	// proxy calling the target address: CALL(gas, target, 0, 0, 0, 0, 0)
+	proxy := common.BigToAddress(big.NewInt(0x1234))
+	target := common.BigToAddress(big.NewInt(0x5678))
+	proxyCode := append(common.FromHex("0x6000600060006000600073"), target.Bytes()...)
+	proxyCode = append(proxyCode, common.FromHex("0x5af15000")...)
+	// contract sending its balance to the target address: SELFDESTRUCT(target)
+	destructor := common.BigToAddress(big.NewInt(0x9abc))
+	destructorCode := append(append(common.FromHex("0x73"), target.Bytes()...), byte(vm.SELFDESTRUCT))
+
+	testCases := []struct {
+		name    string
+		to      common.Address
+		blocked []string
+		expErr  bool
+		expFail bool
+	}{
+		{"no blocked addresses", proxy, nil, false, false},
+		{"blocked sender", proxy, []string{suite.address.Hex()}, true, false},
+		{"blocked recipient", proxy, []string{proxy.Hex()}, true, false},
+		{"blocked address in a nested call", proxy, []string{target.Hex()}, false, true},
+		{"blocked beneficiary of a selfdestruct", destructor, []string{target.Hex()}, false, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			vmdb := suite.StateDB()
+			vmdb.SetCode(proxy, proxyCode)
+			vmdb.SetCode(destructor, destructorCode)
+			vmdb.AddBalance(destructor, uint256.NewInt(1))
+			suite.Require().NoError(vmdb.Commit())
+
+			proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+			config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, big.NewInt(9000))
+			suite.Require().NoError(err)
+			config.Params.BlockedAddresses = tc.blocked
+
+			msg := &core.Message{
+				From:      suite.address,
+				To:        &tc.to,
+				Nonce:     suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+				Value:     big.NewInt(0),
+				GasLimit:  100000,
+				GasPrice:  big.NewInt(0),
+				GasFeeCap: big.NewInt(0),
+				GasTipCap: big.NewInt(0),
+			}
+			txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+			res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+			if tc.expErr {
+				suite.Require().ErrorIs(err, types.ErrBlockedAddress)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expFail, res.Failed())
+			if tc.expFail {
+				suite.Require().Contains(res.VmError, types.ErrBlockedAddress.Error())
+				// the value transfers to the blocked address are reverted
+				suite.Require().Zero(suite.app.EvmKeeper.GetBalance(suite.ctx, target).Sign())
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) createContractGethMsg(nonce uint64, signer ethtypes.Signer, cfg *params.ChainConfig, gasPrice *big.Int) (*core.Message, error) {
 	ethMsg, err := suite.createContractMsgTx(nonce, signer, cfg, gasPrice)
 	if err != nil {
 		return nil, err
 	}
 
-	msgSigner := ethermint.MakeSigner(cfg, big.NewInt(suite.ctx.BlockHeight()))
+	msgSigner := ethermint.MakeSigner(cfg, big.NewInt(suite.ctx.BlockHeight()), ethermint.BlockTime(suite.ctx))
 	return ethMsg.AsMessage(msgSigner, nil)
 }
 
@@ -690,6 +898,43 @@ func (suite *KeeperTestSuite) createContractMsgTx(nonce uint64, signer ethtypes.
 	return ethMsg, ethMsg.Sign(signer, suite.signer)
 }
 
+func (suite *KeeperTestSuite) TestBlockGasUsedTransient() {
+	suite.SetupTest()
+	suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetBlockGasUsedTransient(suite.ctx))
+
+	signer := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+	var expGasUsed uint64
+	for i := 0; i < 2; i++ {
+		nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+		tx := types.NewTx(suite.app.EvmKeeper.ChainID(), nonce, &common.Address{}, nil, 50000, big.NewInt(0), nil, nil, nil, nil)
+		tx.From = suite.address.Hex()
+		suite.Require().NoError(tx.Sign(signer, suite.signer))
+
+		res, err := suite.app.EvmKeeper.ApplyTransaction(suite.ctx, tx)
+		suite.Require().NoError(err)
+		expGasUsed += res.GasUsed
+	}
+	suite.Require().Equal(expGasUsed, suite.app.EvmKeeper.GetBlockGasUsedTransient(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestBlockGasLimit() {
+	suite.SetupTest()
+	maxGas := uint64(30_000_000)
+	suite.ctx = suite.ctx.WithConsensusParams(tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: int64(maxGas)},
+	})
+	suite.Require().Equal(maxGas, suite.app.EvmKeeper.BlockGasLimit(suite.ctx))
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.BlockGasLimit = maxGas / 2
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+	suite.Require().Equal(maxGas/2, suite.app.EvmKeeper.BlockGasLimit(suite.ctx))
+
+	params.BlockGasLimit = maxGas * 2
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+	suite.Require().Equal(maxGas, suite.app.EvmKeeper.BlockGasLimit(suite.ctx))
+}
+
 func (suite *KeeperTestSuite) TestGetProposerAddress() {
 	var a sdk.ConsAddress
 	address := sdk.ConsAddress(suite.address.Bytes())
@@ -48,9 +48,15 @@ func (k *Keeper) GetAccount(ctx sdk.Context, addr common.Address) *statedb.Accou
 	return acct
 }
 
-// GetState loads contract state from database, implements `statedb.Keeper` interface.
+// GetState loads contract state of the current storage incarnation from database.
 func (k *Keeper) GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+	return k.GetIncarnationState(ctx, addr, k.GetIncarnation(ctx, addr), key)
+}
+
+// GetIncarnationState loads contract state of the given storage incarnation from database,
+// implements `statedb.Keeper` interface.
+func (k *Keeper) GetIncarnationState(ctx sdk.Context, addr common.Address, incarnation uint64, key common.Hash) common.Hash {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr, incarnation))
 
 	value := store.Get(key.Bytes())
 	if len(value) == 0 {
@@ -68,10 +74,9 @@ func (k *Keeper) GetCode(ctx sdk.Context, codeHash common.Hash) []byte {
 
 // ForEachStorage iterate contract storage, callback return false to break early
 func (k *Keeper) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
-	store := ctx.KVStore(k.storeKey)
-	prefix := types.AddressStoragePrefix(addr)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr, k.GetIncarnation(ctx, addr)))
 
-	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	iterator := store.Iterator(nil, nil)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
@@ -157,7 +162,7 @@ func (k *Keeper) SetAccount(ctx sdk.Context, addr common.Address, account stated
 
 // SetState update contract storage, delete if value is empty.
 func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr, k.GetIncarnation(ctx, addr)))
 	action := "updated"
 	if len(value) == 0 {
 		store.Delete(key.Bytes())
@@ -190,10 +195,71 @@ func (k *Keeper) SetCode(ctx sdk.Context, codeHash, code []byte) {
 	)
 }
 
+// GetIncarnation returns the current storage incarnation of the account, zero if the
+// account storage has never been deleted, implements `statedb.Keeper` interface.
+func (k *Keeper) GetIncarnation(ctx sdk.Context, addr common.Address) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.IncarnationKey(addr))
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// DeleteStorage drops the account storage in constant time: the storage incarnation of
+// the account is bumped, so the slots of the previous incarnation are no longer reachable
+// and are garbage-collected lazily by PruneStaleStorage.
+func (k *Keeper) DeleteStorage(ctx sdk.Context, addr common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	incarnation := k.GetIncarnation(ctx, addr)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.AddressStoragePrefix(addr, incarnation))
+	hasStorage := iterator.Valid()
+	iterator.Close()
+
+	if hasStorage {
+		store.Set(types.StaleStorageKey(addr, incarnation), []byte{1})
+	}
+	store.Set(types.IncarnationKey(addr), sdk.Uint64ToBigEndian(incarnation+1))
+}
+
+// PruneStaleStorage deletes up to limit storage slots of the stale account incarnations
+// and returns the number of slots deleted.
+func (k *Keeper) PruneStaleStorage(ctx sdk.Context, limit int) int {
+	store := ctx.KVStore(k.storeKey)
+	staleStore := prefix.NewStore(store, types.KeyPrefixStaleStorage)
+
+	// collect the keys first, the stores are not mutated while iterating
+	var storageKeys, finished [][]byte
+	iterator := staleStore.Iterator(nil, nil)
+	for ; iterator.Valid() && len(storageKeys) < limit; iterator.Next() {
+		addr, incarnation := types.SplitStaleStorageKey(iterator.Key())
+		storagePrefix := types.AddressStoragePrefix(addr, incarnation)
+
+		storageIterator := storetypes.KVStorePrefixIterator(store, storagePrefix)
+		for ; storageIterator.Valid() && len(storageKeys) < limit; storageIterator.Next() {
+			storageKeys = append(storageKeys, storageIterator.Key())
+		}
+		if !storageIterator.Valid() {
+			finished = append(finished, iterator.Key())
+		}
+		storageIterator.Close()
+	}
+	iterator.Close()
+
+	for _, key := range storageKeys {
+		store.Delete(key)
+	}
+	for _, key := range finished {
+		staleStore.Delete(key)
+	}
+
+	return len(storageKeys)
+}
+
 // DeleteAccount handles contract's suicide call:
 // - clear balance
 // - remove code
-// - remove states
+// - remove states, by bumping the storage incarnation
 // - remove auth account
 func (k *Keeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
@@ -214,10 +280,7 @@ func (k *Keeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
 	}
 
 	// clear storage
-	k.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
-		k.SetState(ctx, addr, key, nil)
-		return true
-	})
+	k.DeleteStorage(ctx, addr)
 
 	// remove auth account
 	k.accountKeeper.RemoveAccount(ctx, acct)
@@ -933,3 +933,63 @@ func (suite *KeeperTestSuite) TestDeleteAccount() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestDeleteStorage() {
+	key := common.BytesToHash([]byte("key"))
+	value := common.BytesToHash([]byte("value"))
+
+	suite.app.EvmKeeper.SetState(suite.ctx, suite.address, key, value.Bytes())
+	suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetIncarnation(suite.ctx, suite.address))
+
+	suite.app.EvmKeeper.DeleteStorage(suite.ctx, suite.address)
+	suite.Require().Equal(uint64(1), suite.app.EvmKeeper.GetIncarnation(suite.ctx, suite.address))
+	suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, suite.address, key))
+	suite.Require().Empty(suite.app.EvmKeeper.GetAccountStorage(suite.ctx, suite.address))
+
+	// the new incarnation starts with an empty storage
+	suite.app.EvmKeeper.SetState(suite.ctx, suite.address, key, common.BytesToHash([]byte("new")).Bytes())
+	suite.Require().Equal(common.BytesToHash([]byte("new")), suite.app.EvmKeeper.GetState(suite.ctx, suite.address, key))
+
+	// the stale slot is still in the store until garbage-collected
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	suite.Require().True(store.Has(types.StateKey(suite.address, 0, key.Bytes())))
+	suite.Require().True(store.Has(types.StaleStorageKey(suite.address, 0)))
+}
+
+func (suite *KeeperTestSuite) TestPruneStaleStorage() {
+	addr2 := tests.GenerateAddress()
+	for i := 0; i < 3; i++ {
+		key := common.BytesToHash([]byte(fmt.Sprintf("key%d", i)))
+		suite.app.EvmKeeper.SetState(suite.ctx, suite.address, key, []byte{1})
+		suite.app.EvmKeeper.SetState(suite.ctx, addr2, key, []byte{1})
+	}
+	suite.app.EvmKeeper.DeleteStorage(suite.ctx, suite.address)
+	suite.app.EvmKeeper.DeleteStorage(suite.ctx, addr2)
+
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	countStale := func() int {
+		count := 0
+		iterator := prefix.NewStore(store, types.KeyPrefixStorage).Iterator(nil, nil)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			count++
+		}
+		return count
+	}
+	suite.Require().Equal(6, countStale())
+
+	// the first incarnation is fully pruned, the second one partially
+	suite.Require().Equal(4, suite.app.EvmKeeper.PruneStaleStorage(suite.ctx, 4))
+	suite.Require().Equal(2, countStale())
+	suite.Require().NotEqual(
+		store.Has(types.StaleStorageKey(suite.address, 0)),
+		store.Has(types.StaleStorageKey(addr2, 0)),
+	)
+
+	suite.Require().Equal(2, suite.app.EvmKeeper.PruneStaleStorage(suite.ctx, 4))
+	suite.Require().Equal(0, countStale())
+	suite.Require().False(store.Has(types.StaleStorageKey(suite.address, 0)))
+	suite.Require().False(store.Has(types.StaleStorageKey(addr2, 0)))
+
+	suite.Require().Equal(0, suite.app.EvmKeeper.PruneStaleStorage(suite.ctx, 4))
+}
@@ -16,19 +16,33 @@
 package keeper
 
 import (
+	"encoding/json"
+	"fmt"
 	"math/big"
 	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/zeta-chain/ethermint/x/evm/statedb"
 	"github.com/zeta-chain/ethermint/x/evm/types"
 )
 
+// traceFileExt is the extension of the block trace files written by FileTraceSink.
+const traceFileExt = ".jsonl"
+
 // NewTracer creates a new Logger tracer to collect execution traces from an
 // EVM transaction.
-func NewTracer(tracer string, msg *core.Message, cfg *params.ChainConfig, height int64) vm.EVMLogger {
+func NewTracer(tracer string, msg *core.Message, cfg *params.ChainConfig, height int64, time uint64) vm.EVMLogger {
 	// TODO: enable additional log configuration
 	logCfg := &logger.Config{
 		Debug: true,
@@ -36,7 +50,7 @@ func NewTracer(tracer string, msg *core.Message, cfg *params.ChainConfig, height
 
 	switch tracer {
 	case types.TracerAccessList:
-		preCompiles := vm.ActivePrecompiles(cfg.Rules(big.NewInt(height), cfg.MergeNetsplitBlock != nil, 1))
+		preCompiles := vm.ActivePrecompiles(cfg.Rules(big.NewInt(height), cfg.MergeNetsplitBlock != nil, time))
 		return logger.NewAccessListTracer(msg.AccessList, msg.From, *msg.To, preCompiles)
 	case types.TracerJSON:
 		return logger.NewJSONLogger(logCfg, os.Stderr)
@@ -48,3 +62,200 @@ func NewTracer(tracer string, msg *core.Message, cfg *params.ChainConfig, height
 		return types.NewNoOpTracer()
 	}
 }
+
+// NewNativeTracer creates a go-ethereum native tracer (e.g. callTracer) to collect
+// the execution trace of a transaction executed in a block.
+func NewNativeTracer(tracer string, tracerConfig json.RawMessage, txConfig statedb.TxConfig) (tracers.Tracer, error) {
+	if !types.IsNativeTracer(tracer) {
+		return nil, fmt.Errorf("%s is not a native tracer", tracer)
+	}
+
+	tCtx := &tracers.Context{
+		BlockHash: txConfig.BlockHash,
+		// #nosec G115 TxIndex always positive
+		TxIndex: int(txConfig.TxIndex),
+		TxHash:  txConfig.TxHash,
+	}
+	return tracers.DefaultDirectory.New(tracer, tCtx, tracerConfig)
+}
+
+// nativeTracer returns the node-level native tracer for a transaction executed in
+// a block, it returns nil if no native tracer or trace sink is configured.
+func (k *Keeper) nativeTracer(ctx sdk.Context, txConfig statedb.TxConfig) tracers.Tracer {
+	if k.traceSink == nil || !types.IsNativeTracer(k.tracer) || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return nil
+	}
+
+	tracer, err := NewNativeTracer(k.tracer, k.tracerConfig, txConfig)
+	if err != nil {
+		k.Logger(ctx).Error("failed to create native tracer", "tracer", k.tracer, "error", err)
+		return nil
+	}
+	return tracer
+}
+
+// writeTrace writes the result of the native tracer to the trace sink, failures
+// are only logged as tracing must not affect the transaction execution.
+func (k *Keeper) writeTrace(ctx sdk.Context, txHash common.Hash, tracer tracers.Tracer) {
+	result, err := tracer.GetResult()
+	if err == nil {
+		err = k.traceSink.WriteTrace(ctx.BlockHeight(), txHash, result)
+	}
+	if err != nil {
+		k.Logger(ctx).Error("failed to write trace", "tx-hash", txHash.Hex(), "error", err)
+	}
+}
+
+var _ types.TraceSink = &FileTraceSink{}
+
+// FileTraceSink writes the trace results of each block to its own file in the
+// configured directory, one json object per line. The files of the oldest blocks
+// are pruned when a new file is started, to retain a limited number of blocks and
+// a limited total size.
+type FileTraceSink struct {
+	dir string
+	// retainBlocks is the number of the most recent blocks whose trace files are
+	// kept, zero keeps the files of all the blocks.
+	retainBlocks uint64
+	// maxSize is the maximum total size in bytes of the trace files, zero means no limit.
+	maxSize uint64
+
+	mtx    sync.Mutex
+	height int64
+	file   *os.File
+}
+
+// traceEntry is a single line of a block trace file.
+type traceEntry struct {
+	TxHash common.Hash     `json:"txHash"`
+	Result json.RawMessage `json:"result"`
+}
+
+// traceFile is a block trace file of the trace directory.
+type traceFile struct {
+	height int64
+	size   uint64
+}
+
+// NewFileTraceSink creates a FileTraceSink writing to the given directory, which
+// keeps the trace files of the last retainBlocks blocks up to a total of maxSize
+// bytes. A zero value disables the corresponding limit.
+func NewFileTraceSink(dir string, retainBlocks, maxSize uint64) (*FileTraceSink, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create trace directory: %w", err)
+	}
+	return &FileTraceSink{dir: dir, retainBlocks: retainBlocks, maxSize: maxSize}, nil
+}
+
+// WriteTrace implements types.TraceSink. A new file is started whenever the block
+// height changes, a re-executed block overwrites its previous file.
+func (s *FileTraceSink) WriteTrace(height int64, txHash common.Hash, result json.RawMessage) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.file == nil || s.height != height {
+		if err := s.close(); err != nil {
+			return err
+		}
+		if err := s.prune(height); err != nil {
+			return err
+		}
+		file, err := os.OpenFile(s.Path(height), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o640)
+		if err != nil {
+			return err
+		}
+		s.file = file
+		s.height = height
+	}
+
+	bz, err := json.Marshal(traceEntry{TxHash: txHash, Result: result})
+	if err != nil {
+		return err
+	}
+	_, err = s.file.Write(append(bz, '\n'))
+	return err
+}
+
+// Path returns the path of the trace file of the given block height.
+func (s *FileTraceSink) Path(height int64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%d%s", height, traceFileExt))
+}
+
+// prune removes the trace files falling out of the retained blocks before the
+// trace file of the given height is started, then the oldest files until the
+// total size is within the size limit.
+func (s *FileTraceSink) prune(height int64) error {
+	if s.retainBlocks == 0 && s.maxSize == 0 {
+		return nil
+	}
+
+	files, err := s.traceFiles()
+	if err != nil {
+		return err
+	}
+
+	var total uint64
+	for _, file := range files {
+		total += file.size
+	}
+
+	for _, file := range files {
+		// #nosec G115 retainBlocks is a node setting
+		expired := s.retainBlocks > 0 && file.height <= height-int64(s.retainBlocks)
+		oversized := s.maxSize > 0 && total > s.maxSize
+		if !expired && !oversized {
+			break
+		}
+		if err := os.Remove(s.Path(file.height)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		total -= file.size
+	}
+	return nil
+}
+
+// traceFiles returns the block trace files of the trace directory, ordered by
+// ascending height.
+func (s *FileTraceSink) traceFiles() ([]traceFile, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]traceFile, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, traceFileExt) {
+			continue
+		}
+		height, err := strconv.ParseInt(strings.TrimSuffix(name, traceFileExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		// #nosec G115 file sizes are never negative
+		files = append(files, traceFile{height: height, size: uint64(info.Size())})
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].height < files[j].height })
+	return files, nil
+}
+
+// Close closes the trace file of the last traced block.
+func (s *FileTraceSink) Close() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.close()
+}
+
+func (s *FileTraceSink) close() error {
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/ethermint/x/evm/keeper"
+	"github.com/zeta-chain/ethermint/x/evm/statedb"
+	"github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+func TestNewNativeTracer(t *testing.T) {
+	txConfig := statedb.NewEmptyTxConfig(common.Hash{})
+
+	testCases := []struct {
+		name         string
+		tracer       string
+		tracerConfig json.RawMessage
+		expPass      bool
+	}{
+		{"call tracer", "callTracer", nil, true},
+		{"call tracer with config", "callTracer", json.RawMessage(`{"onlyTopCall":true}`), true},
+		{"prestate tracer", "prestateTracer", nil, true},
+		{"4byte tracer", "4byteTracer", nil, true},
+		{"invalid tracer config", "callTracer", json.RawMessage(`{"onlyTopCall":1}`), false},
+		{"struct logger is not a native tracer", types.TracerStruct, nil, false},
+		{"javascript tracer is not a native tracer", "{}", nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tracer, err := keeper.NewNativeTracer(tc.tracer, tc.tracerConfig, txConfig)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.NotNil(t, tracer)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestFileTraceSink(t *testing.T) {
+	sink, err := keeper.NewFileTraceSink(t.TempDir(), 0, 0)
+	require.NoError(t, err)
+
+	txHash1 := common.BytesToHash([]byte{1})
+	txHash2 := common.BytesToHash([]byte{2})
+	require.NoError(t, sink.WriteTrace(1, txHash1, json.RawMessage(`{"type":"CALL"}`)))
+	require.NoError(t, sink.WriteTrace(1, txHash2, json.RawMessage(`{"type":"CREATE"}`)))
+	// a new block rotates the file
+	require.NoError(t, sink.WriteTrace(2, txHash1, json.RawMessage(`{}`)))
+	require.NoError(t, sink.Close())
+
+	bz, err := os.ReadFile(sink.Path(1))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(bz)), "\n")
+	require.Len(t, lines, 2)
+	require.JSONEq(t, `{"txHash":"`+txHash1.Hex()+`","result":{"type":"CALL"}}`, lines[0])
+	require.JSONEq(t, `{"txHash":"`+txHash2.Hex()+`","result":{"type":"CREATE"}}`, lines[1])
+
+	bz, err = os.ReadFile(sink.Path(2))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"txHash":"`+txHash1.Hex()+`","result":{}}`, strings.TrimSpace(string(bz)))
+}
+
+func TestFileTraceSinkPruning(t *testing.T) {
+	txHash := common.BytesToHash([]byte{1})
+	result := json.RawMessage(`{"type":"CALL"}`)
+
+	exists := func(sink *keeper.FileTraceSink, height int64) bool {
+		_, err := os.Stat(sink.Path(height))
+		return err == nil
+	}
+
+	// only the files of the last 2 blocks are retained
+	sink, err := keeper.NewFileTraceSink(t.TempDir(), 2, 0)
+	require.NoError(t, err)
+	for height := int64(1); height <= 4; height++ {
+		require.NoError(t, sink.WriteTrace(height, txHash, result))
+	}
+	require.NoError(t, sink.Close())
+	require.False(t, exists(sink, 1))
+	require.False(t, exists(sink, 2))
+	require.True(t, exists(sink, 3))
+	require.True(t, exists(sink, 4))
+
+	// the oldest files are removed above the size limit
+	sink, err = keeper.NewFileTraceSink(t.TempDir(), 0, 250)
+	require.NoError(t, err)
+	for height := int64(1); height <= 4; height++ {
+		require.NoError(t, sink.WriteTrace(height, txHash, result))
+		require.NoError(t, sink.WriteTrace(height, txHash, result))
+	}
+	require.NoError(t, sink.Close())
+	info, err := os.Stat(sink.Path(4))
+	require.NoError(t, err)
+	// each file holds two lines of ~100 bytes, a single file fits in the limit
+	require.Less(t, info.Size(), int64(250))
+	require.Greater(t, info.Size(), int64(125))
+	require.False(t, exists(sink, 1))
+	require.False(t, exists(sink, 2))
+	require.True(t, exists(sink, 3))
+	require.True(t, exists(sink, 4))
+}
@@ -63,25 +63,78 @@ func GetProposerAddress(ctx sdk.Context, proposerAddress sdk.ConsAddress) sdk.Co
 	return proposerAddress
 }
 
-// DeductTxCostsFromUserBalance deducts the fees from the user balance. Returns an
-// error if the specified sender address does not exist or the account balance is not sufficient.
+// DeductTxCostsFromUserBalance deducts the fees from the user balance and returns the deducted
+// coins. The fees are paid in the evm denom if the sender balance covers them along with the
+// transferred value, otherwise in the first alternative fee denom the sender can afford. Returns
+// an error if the specified sender address does not exist or the account balance is not sufficient.
 func (k *Keeper) DeductTxCostsFromUserBalance(
 	ctx sdk.Context,
 	fees sdk.Coins,
 	from common.Address,
-) error {
+	value *big.Int,
+	txHash common.Hash,
+) (sdk.Coins, error) {
 	// fetch sender account
 	signerAcc, err := authante.GetSignerAcc(ctx, k.accountKeeper, from.Bytes())
 	if err != nil {
-		return errorsmod.Wrapf(err, "account not found for sender %s", from)
+		return nil, errorsmod.Wrapf(err, "account not found for sender %s", from)
+	}
+
+	if fee, ok := k.alternativeFee(ctx, fees, signerAcc.GetAddress(), value); ok {
+		fees = sdk.Coins{fee}
+		// record the payment to refund the leftover gas in the same denom
+		k.SetFeePaymentTransient(ctx, txHash, fee)
 	}
 
 	// deduct the full gas cost from the user balance
 	if err := authante.DeductFees(k.bankKeeper, ctx, signerAcc, fees); err != nil {
-		return errorsmod.Wrapf(err, "failed to deduct full gas cost %s from the user %s balance", fees, from)
+		return nil, errorsmod.Wrapf(err, "failed to deduct full gas cost %s from the user %s balance", fees, from)
 	}
 
-	return nil
+	return fees, nil
+}
+
+// alternativeFee converts the fees to the first alternative fee denom covered by the sender
+// balance. It returns false if the evm denom balance covers both the fees and the value, or if
+// no alternative fee denom is affordable.
+func (k *Keeper) alternativeFee(ctx sdk.Context, fees sdk.Coins, sender sdk.AccAddress, value *big.Int) (sdk.Coin, bool) {
+	params := k.GetParams(ctx)
+	if len(params.FeeDenoms) == 0 || fees.IsZero() {
+		return sdk.Coin{}, false
+	}
+
+	evmFee := fees.AmountOf(params.EvmDenom)
+	cost := evmFee
+	if value != nil {
+		cost = cost.Add(sdkmath.NewIntFromBigInt(value))
+	}
+
+	if k.bankKeeper.GetBalance(ctx, sender, params.EvmDenom).Amount.GTE(cost) {
+		return sdk.Coin{}, false
+	}
+
+	for _, feeDenom := range params.FeeDenoms {
+		rate := k.GetFeeDenomRate(ctx, feeDenom)
+		// round up in favor of the fee collector
+		fee := sdk.NewCoin(feeDenom.Denom, rate.MulInt(evmFee).Ceil().TruncateInt())
+		if k.bankKeeper.GetBalance(ctx, sender, feeDenom.Denom).IsGTE(fee) {
+			return fee, true
+		}
+	}
+
+	return sdk.Coin{}, false
+}
+
+// GetFeeDenomRate returns the amount of the alternative fee denom equivalent to one unit of the
+// evm denom. The fee rate oracle takes precedence over the rate set in the params.
+func (k Keeper) GetFeeDenomRate(ctx sdk.Context, feeDenom types.FeeDenom) sdkmath.LegacyDec {
+	if k.feeRateOracle != nil {
+		rate, ok := k.feeRateOracle.GetFeeRate(ctx, feeDenom.Denom)
+		if ok && !rate.IsNil() && rate.IsPositive() {
+			return rate
+		}
+	}
+	return feeDenom.Rate
 }
 
 // VerifyFee is used to return the fee for the given transaction data in sdk.Coins. It checks that the
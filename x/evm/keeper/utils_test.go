@@ -10,6 +10,7 @@ import (
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 	"github.com/zeta-chain/ethermint/app"
+	"github.com/zeta-chain/ethermint/testutil"
 	"github.com/zeta-chain/ethermint/x/evm/keeper"
 	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
 )
@@ -514,7 +515,7 @@ func (suite *KeeperTestSuite) TestVerifyFeeAndDeductTxCostsFromUserBalance() {
 				suite.Require().Nil(fees, "invalid test %d passed. fees value must be nil - '%s'", i, tc.name)
 			}
 
-			err = suite.app.EvmKeeper.DeductTxCostsFromUserBalance(suite.ctx, fees, common.HexToAddress(tx.From))
+			_, err = suite.app.EvmKeeper.DeductTxCostsFromUserBalance(suite.ctx, fees, common.HexToAddress(tx.From), txData.GetValue(), common.HexToHash(tx.Hash))
 			if tc.expectPassDeduct {
 				suite.Require().NoError(err, "valid test %d failed - '%s'", i, tc.name)
 			} else {
@@ -524,3 +525,141 @@ func (suite *KeeperTestSuite) TestVerifyFeeAndDeductTxCostsFromUserBalance() {
 	}
 	suite.enableFeemarket = false // reset flag
 }
+
+// feeRateOracle is a fee rate oracle returning fixed rates
+type feeRateOracle map[string]sdkmath.LegacyDec
+
+func (o feeRateOracle) GetFeeRate(_ sdk.Context, denom string) (sdkmath.LegacyDec, bool) {
+	rate, ok := o[denom]
+	return rate, ok
+}
+
+func (suite *KeeperTestSuite) TestDeductTxCostsInFeeDenom() {
+	fees := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewInt(1000)))
+	feeDenoms := []evmtypes.FeeDenom{
+		{Denom: "uatom", Rate: sdkmath.LegacyNewDec(2)},
+		{Denom: "uosmo", Rate: sdkmath.LegacyNewDecWithPrec(5, 1)},
+	}
+
+	testCases := []struct {
+		name      string
+		balance   sdk.Coins
+		value     *big.Int
+		oracle    evmtypes.FeeRateOracle
+		expFees   sdk.Coins
+		expRecord bool
+		expPass   bool
+	}{
+		{
+			"evm denom balance covers the fees",
+			sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 1000), sdk.NewInt64Coin("uatom", 2000)),
+			nil,
+			nil,
+			fees,
+			false,
+			true,
+		},
+		{
+			"evm denom balance doesn't cover the value",
+			sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 1000), sdk.NewInt64Coin("uatom", 2000)),
+			big.NewInt(1),
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000)),
+			true,
+			true,
+		},
+		{
+			"first affordable fee denom",
+			sdk.NewCoins(sdk.NewInt64Coin("uatom", 1999), sdk.NewInt64Coin("uosmo", 500)),
+			nil,
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin("uosmo", 500)),
+			true,
+			true,
+		},
+		{
+			"oracle rate takes precedence",
+			sdk.NewCoins(sdk.NewInt64Coin("uatom", 3000)),
+			nil,
+			feeRateOracle{"uatom": sdkmath.LegacyNewDec(3)},
+			sdk.NewCoins(sdk.NewInt64Coin("uatom", 3000)),
+			true,
+			true,
+		},
+		{
+			"insufficient balance in every denom",
+			sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 999), sdk.NewInt64Coin("uatom", 1999)),
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.FeeDenoms = feeDenoms
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+			if tc.oracle != nil {
+				suite.app.EvmKeeper.SetFeeRateOracle(tc.oracle)
+			}
+
+			addr := common.BytesToAddress([]byte("sender"))
+			suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes()))
+			suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, addr.Bytes(), tc.balance))
+
+			txHash := common.BytesToHash([]byte("tx"))
+			deducted, err := suite.app.EvmKeeper.DeductTxCostsFromUserBalance(suite.ctx, fees, addr, tc.value, txHash)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expFees, deducted)
+			suite.Require().Equal(tc.balance.Sub(deducted...), suite.app.BankKeeper.GetAllBalances(suite.ctx, addr.Bytes()))
+
+			payment, found := suite.app.EvmKeeper.GetFeePaymentTransient(suite.ctx, txHash)
+			suite.Require().Equal(tc.expRecord, found)
+			if found {
+				suite.Require().Equal(deducted[0], payment)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundGasInFeeDenom() {
+	suite.SetupTest()
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.FeeDenoms = []evmtypes.FeeDenom{{Denom: "uatom", Rate: sdkmath.LegacyNewDec(2)}}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	initBalance := sdk.NewInt64Coin("uatom", 10_000_000)
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, suite.address.Bytes(), sdk.NewCoins(initBalance)))
+
+	// the sender has no evm denom balance, the fees are paid in uatom
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	tx := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), nonce, &common.Address{}, nil, 50000, big.NewInt(10), nil, nil, nil, nil)
+	tx.From = suite.address.Hex()
+	suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID()), suite.signer))
+
+	txData, err := evmtypes.UnpackTxData(tx.Data)
+	suite.Require().NoError(err)
+	fees, err := keeper.VerifyFee(txData, evmtypes.DefaultEVMDenom, big.NewInt(0), true, true, true, false)
+	suite.Require().NoError(err)
+	deducted, err := suite.app.EvmKeeper.DeductTxCostsFromUserBalance(suite.ctx, fees, suite.address, txData.GetValue(), common.HexToHash(tx.Hash))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uatom", 2*50000*10)), deducted)
+
+	res, err := suite.app.EvmKeeper.ApplyTransaction(suite.ctx, tx)
+	suite.Require().NoError(err)
+
+	// the leftover gas is refunded in uatom
+	paid := sdkmath.NewIntFromUint64(2 * res.GasUsed * 10)
+	suite.Require().Equal(initBalance.Amount.Sub(paid), suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), "uatom").Amount)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), evmtypes.DefaultEVMDenom).IsZero())
+}
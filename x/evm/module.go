@@ -35,6 +35,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/zeta-chain/ethermint/x/evm/client/cli"
 	"github.com/zeta-chain/ethermint/x/evm/keeper"
@@ -50,7 +51,21 @@ var (
 )
 
 // AppModuleBasic defines the basic application module used by the evm module.
-type AppModuleBasic struct{}
+type AppModuleBasic struct {
+	// activePrecompiles are the hex addresses of the precompiled contracts active in
+	// the default genesis
+	activePrecompiles []string
+}
+
+// NewAppModuleBasic creates a new AppModuleBasic whose default genesis activates the
+// given precompiled contracts, they must be registered on the keeper by the app.
+func NewAppModuleBasic(activePrecompiles ...common.Address) AppModuleBasic {
+	addresses := make([]string, len(activePrecompiles))
+	for i, addr := range activePrecompiles {
+		addresses[i] = addr.Hex()
+	}
+	return AppModuleBasic{activePrecompiles: addresses}
+}
 
 // Name returns the evm module's name.
 func (AppModuleBasic) Name() string {
@@ -64,13 +79,15 @@ func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 // ConsensusVersion returns the consensus state-breaking version for the module.
 func (AppModuleBasic) ConsensusVersion() uint64 {
-	return 5
+	return 8
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the evm
-// module.
-func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	return cdc.MustMarshalJSON(types.DefaultGenesisState())
+// module, with the precompiled contracts of the module basic active.
+func (b AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	genesis := types.DefaultGenesisState()
+	genesis.Params.ActivePrecompiles = append(genesis.Params.ActivePrecompiles, b.activePrecompiles...)
+	return cdc.MustMarshalJSON(genesis)
 }
 
 // ValidateGenesis is the validation check of the Genesis
@@ -135,16 +152,27 @@ func (AppModule) Name() string {
 	return types.ModuleName
 }
 
-// RegisterInvariants interface for registering invariants. Performs a no-op
-// as the evm module doesn't expose invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
+// RegisterInvariants registers the evm module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
-// RegisterServices registers a GRPC query service to respond to the
-// module-specific GRPC queries.
+// RegisterServices registers the GRPC query service and migrator service to respond to the
+// module-specific GRPC queries and handle the upgrade store migration for the module.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(*am.keeper, am.legacySubspace)
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 7, m.Migrate7to8); err != nil {
+		panic(err)
+	}
 }
 
 // BeginBlock returns the begin block for the evm module.
@@ -180,12 +208,18 @@ func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
 }
 
 // GenerateGenesisState creates a randomized GenState of the evm module.
-func (AppModule) GenerateGenesisState(_ *module.SimulationState) {
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (am AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs(am.keeper)
 }
 
 // WeightedOperations returns the all the evm module operations with their respective weights.
-func (am AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, simState.Cdc, simState.TxConfig, am.keeper)
 }
 
 // IsAppModule implements the appmodule.AppModule interface.
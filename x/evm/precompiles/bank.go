@@ -0,0 +1,330 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package precompiles
+
+import (
+	"context"
+	"errors"
+	// embed the abi of the bank precompile
+	_ "embed"
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/zeta-chain/ethermint/x/evm/keeper"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+const (
+	// EventTypeBankApproval is the native event emitted when an allowance is set
+	// through the bank precompile.
+	EventTypeBankApproval = "bank_erc20_approval"
+
+	AttributeKeyOwner   = "owner"
+	AttributeKeySpender = "spender"
+
+	// BankReadGas is the gas charged by the read methods of the bank precompile.
+	BankReadGas uint64 = 3000
+	// BankApproveGas is the gas charged by approve.
+	BankApproveGas uint64 = 25000
+	// BankTransferGas is the gas charged by transfer and transferFrom.
+	BankTransferGas uint64 = 35000
+)
+
+var (
+	//go:embed IBankERC20.json
+	bankABIJSON []byte
+
+	// BankABI is the ERC-20 interface implemented by the bank precompile.
+	BankABI abi.ABI
+
+	bankGas = map[string]uint64{
+		"approve":      BankApproveGas,
+		"transfer":     BankTransferGas,
+		"transferFrom": BankTransferGas,
+	}
+)
+
+func init() {
+	if err := BankABI.UnmarshalJSON(bankABIJSON); err != nil {
+		panic(err)
+	}
+}
+
+// BankKeeper defines the bank methods used by the bank precompile.
+type BankKeeper interface {
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
+// AllowanceKeeper defines the EVM keeper methods used to store the allowances of the
+// bank precompile.
+type AllowanceKeeper interface {
+	GetBankAllowance(ctx sdk.Context, contract, owner, spender common.Address) *big.Int
+	SetBankAllowance(ctx sdk.Context, contract, owner, spender common.Address, value *big.Int)
+}
+
+var _ vm.StatefulPrecompiledContract = (*BankContract)(nil)
+
+// BankContract is a stateful precompiled contract exposing a bank denom through the
+// ERC-20 interface. Balances and transfers are handled by the bank module while the
+// allowances are kept in the EVM module store.
+type BankContract struct {
+	bankKeeper BankKeeper
+	denom      string
+	address    common.Address
+}
+
+// BankContractAddress returns the address of the bank precompile of the given denom.
+func BankContractAddress(denom string) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte("bank/" + denom)))
+}
+
+// NewBankContract creates the bank precompile of the given denom. The EVM denom can't
+// be exposed because its balances are managed by the StateDB.
+func NewBankContract(bankKeeper BankKeeper, denom string) *BankContract {
+	return &BankContract{
+		bankKeeper: bankKeeper,
+		denom:      denom,
+		address:    BankContractAddress(denom),
+	}
+}
+
+// NewBankContractsFn returns the ParamsContractsFn registering on the EVM keeper the
+// bank precompiles of the denoms listed in the bank precompile denoms param, so that
+// governance can expose any denom.
+func NewBankContractsFn(bankKeeper BankKeeper) keeper.ParamsContractsFn {
+	return func(_ sdk.Context, _ params.Rules, evmParams evmtypes.Params) []vm.StatefulPrecompiledContract {
+		contracts := make([]vm.StatefulPrecompiledContract, len(evmParams.BankPrecompileDenoms))
+		for i, denom := range evmParams.BankPrecompileDenoms {
+			contracts[i] = NewBankContract(bankKeeper, denom)
+		}
+		return contracts
+	}
+}
+
+// Address implements vm.ContractRef
+func (bc *BankContract) Address() common.Address {
+	return bc.address
+}
+
+// Denom returns the bank denom exposed by the contract.
+func (bc *BankContract) Denom() string {
+	return bc.denom
+}
+
+// RequiredGas implements vm.StatefulPrecompiledContract
+func (bc *BankContract) RequiredGas(input []byte) uint64 {
+	return requiredGas(BankABI, bankGas, BankReadGas, input)
+}
+
+// Run implements vm.StatefulPrecompiledContract
+func (bc *BankContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	stateDB, err := extStateDB(evm)
+	if err != nil {
+		return nil, err
+	}
+	method, args, err := parseMethod(BankABI, contract.Input)
+	if err != nil {
+		return nil, err
+	}
+	if readonly && !method.IsConstant() {
+		return nil, vm.ErrWriteProtection
+	}
+	if err := rejectValue(stateDB, bc.address); err != nil {
+		return nil, err
+	}
+	allowances, ok := stateDB.Keeper().(AllowanceKeeper)
+	if !ok {
+		return nil, errors.New("state db keeper doesn't support allowances")
+	}
+
+	ctx := stateDB.CacheContext()
+	if bc.denom == stateDB.Keeper().GetParams(ctx).EvmDenom {
+		return nil, fmt.Errorf("the evm denom %s can't be used by the bank precompile", bc.denom)
+	}
+
+	caller := contract.CallerAddress
+	switch method.Name {
+	case "name":
+		metadata, _ := bc.bankKeeper.GetDenomMetaData(ctx, bc.denom)
+		if metadata.Name == "" {
+			return method.Outputs.Pack(bc.denom)
+		}
+		return method.Outputs.Pack(metadata.Name)
+	case "symbol":
+		metadata, _ := bc.bankKeeper.GetDenomMetaData(ctx, bc.denom)
+		if metadata.Symbol == "" {
+			return method.Outputs.Pack(bc.denom)
+		}
+		return method.Outputs.Pack(metadata.Symbol)
+	case "decimals":
+		metadata, _ := bc.bankKeeper.GetDenomMetaData(ctx, bc.denom)
+		return method.Outputs.Pack(decimals(metadata))
+	case "totalSupply":
+		return method.Outputs.Pack(bc.bankKeeper.GetSupply(ctx, bc.denom).Amount.BigInt())
+	case "balanceOf":
+		account := args[0].(common.Address)
+		return method.Outputs.Pack(bc.bankKeeper.GetBalance(ctx, account.Bytes(), bc.denom).Amount.BigInt())
+	case "allowance":
+		owner, spender := args[0].(common.Address), args[1].(common.Address)
+		return method.Outputs.Pack(allowances.GetBankAllowance(ctx, bc.address, owner, spender))
+	case "approve":
+		spender, value := args[0].(common.Address), args[1].(*big.Int)
+		if err := bc.approve(stateDB, allowances, nativeGasLimit(evm), caller, spender, value); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	case "transfer":
+		to, value := args[0].(common.Address), args[1].(*big.Int)
+		err := executeNativeAction(stateDB, bc.address, bc.convertEvent, nativeGasLimit(evm), nil, func(ctx sdk.Context) error {
+			return bc.send(ctx, caller, to, value)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	case "transferFrom":
+		from, to, value := args[0].(common.Address), args[1].(common.Address), args[2].(*big.Int)
+		err := executeNativeAction(stateDB, bc.address, bc.convertEvent, nativeGasLimit(evm), nil, func(ctx sdk.Context) error {
+			allowance := allowances.GetBankAllowance(ctx, bc.address, from, caller)
+			if allowance.Cmp(value) < 0 {
+				return fmt.Errorf("insufficient allowance %s < %s", allowance, value)
+			}
+			// an infinite allowance is never spent
+			if allowance.Cmp(math.MaxBig256) != 0 {
+				allowances.SetBankAllowance(ctx, bc.address, from, caller, new(big.Int).Sub(allowance, value))
+			}
+			return bc.send(ctx, from, to, value)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	default:
+		return nil, fmt.Errorf("unknown method %s", method.Name)
+	}
+}
+
+// send sends the coins with the bank module within a native action, the bank transfer
+// event is converted to the ERC-20 Transfer log.
+func (bc *BankContract) send(ctx sdk.Context, from, to common.Address, value *big.Int) error {
+	coins := sdk.NewCoins(sdk.NewCoin(bc.denom, sdkmath.NewIntFromBigInt(value)))
+	if bc.bankKeeper.BlockedAddr(to.Bytes()) {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive funds", to.Hex())
+	}
+	if err := bc.bankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
+		return err
+	}
+	return bc.bankKeeper.SendCoins(ctx, from.Bytes(), to.Bytes(), coins)
+}
+
+// approve sets the allowance of the spender and emits the approval event, which is
+// converted to the ERC-20 Approval log.
+func (bc *BankContract) approve(
+	stateDB ExtStateDB,
+	allowances AllowanceKeeper,
+	gasLimit uint64,
+	owner, spender common.Address,
+	value *big.Int,
+) error {
+	return executeNativeAction(stateDB, bc.address, bc.convertEvent, gasLimit, nil, func(ctx sdk.Context) error {
+		allowances.SetBankAllowance(ctx, bc.address, owner, spender, value)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			EventTypeBankApproval,
+			sdk.NewAttribute(AttributeKeyOwner, sdk.AccAddress(owner.Bytes()).String()),
+			sdk.NewAttribute(AttributeKeySpender, sdk.AccAddress(spender.Bytes()).String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bc.denom, sdkmath.NewIntFromBigInt(value)).String()),
+		))
+		return nil
+	})
+}
+
+// convertEvent converts the bank transfer and the approval events of the denom to
+// the ERC-20 logs.
+func (bc *BankContract) convertEvent(event sdk.Event) (*ethtypes.Log, error) {
+	var (
+		eventABI       abi.Event
+		fromKey, toKey string
+	)
+	switch event.Type {
+	case banktypes.EventTypeTransfer:
+		eventABI, fromKey, toKey = BankABI.Events["Transfer"], banktypes.AttributeKeySender, banktypes.AttributeKeyRecipient
+	case EventTypeBankApproval:
+		eventABI, fromKey, toKey = BankABI.Events["Approval"], AttributeKeyOwner, AttributeKeySpender
+	default:
+		return nil, nil
+	}
+
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	from, err := sdk.AccAddressFromBech32(attrs[fromKey])
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(attrs[toKey])
+	if err != nil {
+		return nil, err
+	}
+	amount, err := sdk.ParseCoinsNormalized(attrs[sdk.AttributeKeyAmount])
+	if err != nil {
+		return nil, err
+	}
+	data, err := eventABI.Inputs.NonIndexed().Pack(amount.AmountOf(bc.denom).BigInt())
+	if err != nil {
+		return nil, err
+	}
+	return &ethtypes.Log{
+		Topics: []common.Hash{
+			eventABI.ID,
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+		},
+		Data: data,
+	}, nil
+}
+
+// decimals returns the exponent of the display unit of the denom, the base denom has
+// no decimals when the metadata isn't registered.
+func decimals(metadata banktypes.Metadata) uint8 {
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			if unit.Exponent > math.MaxUint8 {
+				return 0
+			}
+			return uint8(unit.Exponent)
+		}
+	}
+	return 0
+}
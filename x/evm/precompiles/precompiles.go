@@ -0,0 +1,199 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package precompiles
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+
+	"github.com/zeta-chain/ethermint/x/evm/statedb"
+)
+
+// ExtStateDB defines the StateDB extensions required by the stateful precompiled
+// contracts to execute native actions.
+type ExtStateDB interface {
+	vm.StateDB
+	ExecuteNativeAction(contract common.Address, converter statedb.EventConverter, action func(ctx sdk.Context) error) error
+	CacheContext() sdk.Context
+	Keeper() statedb.Keeper
+	SetBalance(addr common.Address, amount *uint256.Int)
+	ConsumeNativeGas(gas uint64)
+}
+
+// BalanceKeeper defines the EVM keeper methods used to sync the evm denom balances
+// between the StateDB and the native state.
+type BalanceKeeper interface {
+	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
+	SetBalance(ctx sdk.Context, addr common.Address, amount *big.Int) error
+}
+
+// Coin is the ABI representation of a sdk.Coin.
+type Coin struct {
+	Denom  string
+	Amount *big.Int
+}
+
+// NewCoins converts the sdk coins to their ABI representation.
+func NewCoins(coins sdk.Coins) []Coin {
+	result := make([]Coin, len(coins))
+	for i, coin := range coins {
+		result[i] = Coin{Denom: coin.Denom, Amount: coin.Amount.BigInt()}
+	}
+	return result
+}
+
+// extStateDB returns the StateDB of the EVM as an ExtStateDB.
+func extStateDB(evm *vm.EVM) (ExtStateDB, error) {
+	stateDB, ok := evm.StateDB.(ExtStateDB)
+	if !ok {
+		return nil, errors.New("state db doesn't support native actions")
+	}
+	return stateDB, nil
+}
+
+// rejectValue returns an error if the call to the precompiled contract transfers evm
+// denom funds, which would be locked in the contract. The EVM credits the value to the
+// contract before running it without exposing it to the stateful precompiles, so the
+// value is detected as a balance above the committed balance of the contract.
+func rejectValue(stateDB ExtStateDB, contract common.Address) error {
+	committed := new(uint256.Int)
+	if account := stateDB.Keeper().GetAccount(stateDB.CacheContext(), contract); account != nil {
+		committed = account.Balance
+	}
+	if stateDB.GetBalance(contract).Cmp(committed) > 0 {
+		return errors.New("the precompiled contract doesn't accept value transfers")
+	}
+	return nil
+}
+
+// parseMethod returns the ABI method and the unpacked arguments of the call input.
+func parseMethod(contractABI abi.ABI, input []byte) (*abi.Method, []interface{}, error) {
+	if len(input) < 4 {
+		return nil, nil, errors.New("invalid input length")
+	}
+	method, err := contractABI.MethodById(input[:4])
+	if err != nil {
+		return nil, nil, err
+	}
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, nil, err
+	}
+	return method, args, nil
+}
+
+// requiredGas returns the gas of the method called by the input, it falls back to
+// the default gas if the method is unknown so that the failing call is still charged.
+func requiredGas(contractABI abi.ABI, gasByMethod map[string]uint64, defaultGas uint64, input []byte) uint64 {
+	if len(input) < 4 {
+		return defaultGas
+	}
+	method, err := contractABI.MethodById(input[:4])
+	if err != nil {
+		return defaultGas
+	}
+	if gas, ok := gasByMethod[method.Name]; ok {
+		return gas
+	}
+	return defaultGas
+}
+
+// executeNativeAction executes the native action with a gas meter limited to gasLimit,
+// the store gas it consumes is charged to the transaction. The evm denom balances of
+// the given accounts are written to the native state before the action and read back
+// into the StateDB after it, so that the action sees the balances of the ongoing EVM
+// execution and the commit of the StateDB doesn't override the changes of the action.
+func executeNativeAction(
+	stateDB ExtStateDB,
+	contract common.Address,
+	converter statedb.EventConverter,
+	gasLimit uint64,
+	accounts []common.Address,
+	action func(ctx sdk.Context) error,
+) error {
+	balanceKeeper, ok := stateDB.Keeper().(BalanceKeeper)
+	if !ok {
+		return errors.New("state db keeper doesn't support balance updates")
+	}
+
+	balances := make([]*big.Int, len(accounts))
+	err := stateDB.ExecuteNativeAction(contract, converter, func(ctx sdk.Context) error {
+		// the balance sync is not part of the action, discard its events
+		syncCtx := ctx.WithEventManager(sdk.NewEventManager())
+		for _, addr := range accounts {
+			if err := balanceKeeper.SetBalance(syncCtx, addr, stateDB.GetBalance(addr).ToBig()); err != nil {
+				return err
+			}
+		}
+
+		if err := runWithGasMeter(stateDB, ctx, gasLimit, action); err != nil {
+			return err
+		}
+
+		for i, addr := range accounts {
+			balances[i] = balanceKeeper.GetBalance(ctx, addr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, addr := range accounts {
+		balance, overflow := uint256.FromBig(balances[i])
+		if overflow {
+			return fmt.Errorf("balance overflow of account %s", addr.Hex())
+		}
+		stateDB.SetBalance(addr, balance)
+	}
+	return nil
+}
+
+// nativeGasLimit returns the gas limit of the native actions, they are bounded by the
+// block gas limit as the transaction fails if its gas doesn't cover the store gas
+// consumed anyway.
+func nativeGasLimit(evm *vm.EVM) uint64 {
+	if evm.Context.GasLimit == 0 {
+		return math.MaxUint64
+	}
+	return evm.Context.GasLimit
+}
+
+// runWithGasMeter runs the function with a gas meter limited to gasLimit and charges
+// the store gas it consumed to the transaction, on top of the gas required by the
+// precompiled contract. Running out of gas returns vm.ErrOutOfGas.
+func runWithGasMeter(stateDB ExtStateDB, ctx sdk.Context, gasLimit uint64, fn func(ctx sdk.Context) error) (err error) {
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+	defer func() {
+		stateDB.ConsumeNativeGas(gasMeter.GasConsumedToLimit())
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = vm.ErrOutOfGas
+		}
+	}()
+	return fn(ctx.WithGasMeter(gasMeter))
+}
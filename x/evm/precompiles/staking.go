@@ -0,0 +1,341 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package precompiles
+
+import (
+	// embed the abi of the staking precompile
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/zeta-chain/ethermint/x/evm/keeper"
+	"github.com/zeta-chain/ethermint/x/evm/statedb"
+)
+
+// The gas required by the methods of the staking precompile, the store gas consumed by
+// the staking and distribution modules is charged on top of it.
+const (
+	// StakingQueryGas is the gas required by the query methods of the staking precompile.
+	StakingQueryGas uint64 = 2000
+	// StakingDelegateGas is the gas required by delegate and undelegate.
+	StakingDelegateGas uint64 = 20000
+	// StakingRedelegateGas is the gas required by redelegate.
+	StakingRedelegateGas uint64 = 30000
+	// StakingWithdrawRewardsGas is the gas required by withdrawRewards.
+	StakingWithdrawRewardsGas uint64 = 15000
+)
+
+var (
+	// StakingContractAddress is the address of the staking precompile.
+	StakingContractAddress = common.HexToAddress("0x0000000000000000000000000000000000000800")
+
+	//go:embed IStaking.json
+	stakingABIJSON []byte
+
+	// StakingABI is the interface implemented by the staking precompile.
+	StakingABI abi.ABI
+
+	stakingGas = map[string]uint64{
+		"delegate":        StakingDelegateGas,
+		"undelegate":      StakingDelegateGas,
+		"redelegate":      StakingRedelegateGas,
+		"withdrawRewards": StakingWithdrawRewardsGas,
+	}
+)
+
+func init() {
+	if err := StakingABI.UnmarshalJSON(stakingABIJSON); err != nil {
+		panic(err)
+	}
+}
+
+var _ vm.StatefulPrecompiledContract = (*StakingContract)(nil)
+
+// StakingContract is a stateful precompiled contract giving access to the staking and
+// distribution modules. The caller of the contract is the delegator of the actions.
+type StakingContract struct {
+	stakingKeeper    *stakingkeeper.Keeper
+	distrKeeper      distrkeeper.Keeper
+	stakingMsgServer stakingtypes.MsgServer
+	distrMsgServer   distrtypes.MsgServer
+	distrQuerier     distrtypes.QueryServer
+}
+
+// NewStakingContract creates the staking precompile.
+func NewStakingContract(stakingKeeper *stakingkeeper.Keeper, distrKeeper distrkeeper.Keeper) *StakingContract {
+	return &StakingContract{
+		stakingKeeper:    stakingKeeper,
+		distrKeeper:      distrKeeper,
+		stakingMsgServer: stakingkeeper.NewMsgServerImpl(stakingKeeper),
+		distrMsgServer:   distrkeeper.NewMsgServerImpl(distrKeeper),
+		distrQuerier:     distrkeeper.NewQuerier(distrKeeper),
+	}
+}
+
+// NewStakingContractFn returns the CustomContractFn registering the staking precompile
+// on the EVM keeper.
+func NewStakingContractFn(stakingKeeper *stakingkeeper.Keeper, distrKeeper distrkeeper.Keeper) keeper.CustomContractFn {
+	contract := NewStakingContract(stakingKeeper, distrKeeper)
+	return func(sdk.Context, params.Rules) vm.StatefulPrecompiledContract {
+		return contract
+	}
+}
+
+// Address implements vm.ContractRef
+func (sc *StakingContract) Address() common.Address {
+	return StakingContractAddress
+}
+
+// RequiredGas implements vm.StatefulPrecompiledContract
+func (sc *StakingContract) RequiredGas(input []byte) uint64 {
+	return requiredGas(StakingABI, stakingGas, StakingQueryGas, input)
+}
+
+// Run implements vm.StatefulPrecompiledContract
+func (sc *StakingContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	stateDB, err := extStateDB(evm)
+	if err != nil {
+		return nil, err
+	}
+	method, args, err := parseMethod(StakingABI, contract.Input)
+	if err != nil {
+		return nil, err
+	}
+	if readonly && !method.IsConstant() {
+		return nil, vm.ErrWriteProtection
+	}
+	if err := rejectValue(stateDB, StakingContractAddress); err != nil {
+		return nil, err
+	}
+
+	gasLimit := nativeGasLimit(evm)
+	caller := contract.CallerAddress
+	delegator := sdk.AccAddress(caller.Bytes()).String()
+
+	switch method.Name {
+	case "delegate":
+		validator, amount := args[0].(string), args[1].(*big.Int)
+		err := sc.execute(stateDB, caller, gasLimit, func(ctx sdk.Context) error {
+			coin, err := sc.bondCoin(ctx, amount)
+			if err != nil {
+				return err
+			}
+			_, err = sc.stakingMsgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(delegator, validator, coin))
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	case "undelegate":
+		validator, amount := args[0].(string), args[1].(*big.Int)
+		var res *stakingtypes.MsgUndelegateResponse
+		err := sc.execute(stateDB, caller, gasLimit, func(ctx sdk.Context) error {
+			coin, err := sc.bondCoin(ctx, amount)
+			if err != nil {
+				return err
+			}
+			res, err = sc.stakingMsgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(delegator, validator, coin))
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(res.CompletionTime.Unix())
+	case "redelegate":
+		validatorSrc, validatorDst, amount := args[0].(string), args[1].(string), args[2].(*big.Int)
+		var res *stakingtypes.MsgBeginRedelegateResponse
+		err := sc.execute(stateDB, caller, gasLimit, func(ctx sdk.Context) error {
+			coin, err := sc.bondCoin(ctx, amount)
+			if err != nil {
+				return err
+			}
+			res, err = sc.stakingMsgServer.BeginRedelegate(ctx, stakingtypes.NewMsgBeginRedelegate(delegator, validatorSrc, validatorDst, coin))
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(res.CompletionTime.Unix())
+	case "withdrawRewards":
+		validator := args[0].(string)
+		var res *distrtypes.MsgWithdrawDelegatorRewardResponse
+		err := sc.execute(stateDB, caller, gasLimit, func(ctx sdk.Context) (err error) {
+			res, err = sc.distrMsgServer.WithdrawDelegatorReward(ctx, distrtypes.NewMsgWithdrawDelegatorReward(delegator, validator))
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(NewCoins(res.Amount))
+	case "delegation":
+		delegatorAddr, validator := args[0].(common.Address), args[1].(string)
+		var shares, balance *big.Int
+		err := runWithGasMeter(stateDB, stateDB.CacheContext(), gasLimit, func(ctx sdk.Context) (err error) {
+			shares, balance, err = sc.delegation(ctx, delegatorAddr, validator)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(shares, balance)
+	case "delegationRewards":
+		delegatorAddr, validator := args[0].(common.Address), args[1].(string)
+		var res *distrtypes.QueryDelegationRewardsResponse
+		err := runWithGasMeter(stateDB, stateDB.CacheContext(), gasLimit, func(ctx sdk.Context) (err error) {
+			res, err = sc.distrQuerier.DelegationRewards(ctx, &distrtypes.QueryDelegationRewardsRequest{
+				DelegatorAddress: sdk.AccAddress(delegatorAddr.Bytes()).String(),
+				ValidatorAddress: validator,
+			})
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		rewards, _ := res.Rewards.TruncateDecimal()
+		return method.Outputs.Pack(NewCoins(rewards))
+	default:
+		return nil, fmt.Errorf("unknown method %s", method.Name)
+	}
+}
+
+// execute runs the native action of the delegator, the evm denom balances of the
+// delegator and its rewards withdraw address are synced with the StateDB.
+func (sc *StakingContract) execute(stateDB ExtStateDB, delegator common.Address, gasLimit uint64, action func(ctx sdk.Context) error) error {
+	withdrawAddr, err := sc.distrKeeper.GetDelegatorWithdrawAddr(stateDB.CacheContext(), delegator.Bytes())
+	if err != nil {
+		return err
+	}
+	accounts := []common.Address{delegator}
+	if withdrawer := common.BytesToAddress(withdrawAddr); withdrawer != delegator {
+		accounts = append(accounts, withdrawer)
+	}
+	return executeNativeAction(stateDB, StakingContractAddress, stakingEventConverter(delegator), gasLimit, accounts, action)
+}
+
+// bondCoin returns the coin of the bond denom with the given amount.
+func (sc *StakingContract) bondCoin(ctx sdk.Context, amount *big.Int) (sdk.Coin, error) {
+	bondDenom, err := sc.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(amount)), nil
+}
+
+// delegation returns the shares and the balance of the delegation, both are zero if the
+// delegation doesn't exist. The shares are returned with 18 decimals.
+func (sc *StakingContract) delegation(ctx sdk.Context, delegator common.Address, validator string) (*big.Int, *big.Int, error) {
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return nil, nil, err
+	}
+	delegation, err := sc.stakingKeeper.GetDelegation(ctx, delegator.Bytes(), valAddr)
+	if errors.Is(err, stakingtypes.ErrNoDelegation) {
+		return big.NewInt(0), big.NewInt(0), nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	val, err := sc.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return delegation.Shares.BigInt(), val.TokensFromShares(delegation.Shares).TruncateInt().BigInt(), nil
+}
+
+// stakingEventConverter returns the converter of the staking and distribution events
+// of the delegator to EVM logs.
+func stakingEventConverter(delegator common.Address) statedb.EventConverter {
+	return func(event sdk.Event) (*ethtypes.Log, error) {
+		attrs := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+
+		var (
+			eventABI abi.Event
+			values   []interface{}
+		)
+		switch event.Type {
+		case stakingtypes.EventTypeDelegate:
+			amount, err := sdk.ParseCoinNormalized(attrs[sdk.AttributeKeyAmount])
+			if err != nil {
+				return nil, err
+			}
+			eventABI = StakingABI.Events["Delegate"]
+			values = []interface{}{attrs[stakingtypes.AttributeKeyValidator], amount.Amount.BigInt()}
+		case stakingtypes.EventTypeUnbond:
+			amount, err := sdk.ParseCoinNormalized(attrs[sdk.AttributeKeyAmount])
+			if err != nil {
+				return nil, err
+			}
+			completionTime, err := time.Parse(time.RFC3339, attrs[stakingtypes.AttributeKeyCompletionTime])
+			if err != nil {
+				return nil, err
+			}
+			eventABI = StakingABI.Events["Undelegate"]
+			values = []interface{}{attrs[stakingtypes.AttributeKeyValidator], amount.Amount.BigInt(), completionTime.Unix()}
+		case stakingtypes.EventTypeRedelegate:
+			amount, err := sdk.ParseCoinNormalized(attrs[sdk.AttributeKeyAmount])
+			if err != nil {
+				return nil, err
+			}
+			completionTime, err := time.Parse(time.RFC3339, attrs[stakingtypes.AttributeKeyCompletionTime])
+			if err != nil {
+				return nil, err
+			}
+			eventABI = StakingABI.Events["Redelegate"]
+			values = []interface{}{
+				attrs[stakingtypes.AttributeKeySrcValidator],
+				attrs[stakingtypes.AttributeKeyDstValidator],
+				amount.Amount.BigInt(),
+				completionTime.Unix(),
+			}
+		case distrtypes.EventTypeWithdrawRewards:
+			amount, err := sdk.ParseCoinsNormalized(attrs[sdk.AttributeKeyAmount])
+			if err != nil {
+				return nil, err
+			}
+			eventABI = StakingABI.Events["WithdrawRewards"]
+			values = []interface{}{attrs[distrtypes.AttributeKeyValidator], NewCoins(amount)}
+		default:
+			return nil, nil
+		}
+
+		data, err := eventABI.Inputs.NonIndexed().Pack(values...)
+		if err != nil {
+			return nil, err
+		}
+		return &ethtypes.Log{
+			Topics: []common.Hash{eventABI.ID, common.BytesToHash(delegator.Bytes())},
+			Data:   data,
+		}, nil
+	}
+}
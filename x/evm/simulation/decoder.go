@@ -3,7 +3,9 @@ package simulation
 import (
 	"bytes"
 	"fmt"
+	"math/big"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/zeta-chain/ethermint/x/evm/types"
@@ -19,11 +21,58 @@ func NewDecodeStore() func(kvA, kvB kv.Pair) string {
 			storageB := common.BytesToHash(kvB.Value).Hex()
 
 			return fmt.Sprintf("%v\n%v", storageA, storageB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixIncarnation):
+			incarnationA := sdk.BigEndianToUint64(kvA.Value)
+			incarnationB := sdk.BigEndianToUint64(kvB.Value)
+
+			return fmt.Sprintf("%v\n%v", incarnationA, incarnationB)
 		case bytes.Equal(kvA.Key[:1], types.KeyPrefixCode):
 			codeHashA := common.Bytes2Hex(kvA.Value)
 			codeHashB := common.Bytes2Hex(kvB.Value)
 
 			return fmt.Sprintf("%v\n%v", codeHashA, codeHashB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixParams):
+			var paramsA, paramsB types.Params
+			types.ModuleCdc.MustUnmarshal(kvA.Value, &paramsA)
+			types.ModuleCdc.MustUnmarshal(kvB.Value, &paramsB)
+
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixStaleStorage):
+			addressA, incarnationA := types.SplitStaleStorageKey(kvA.Key[1:])
+			addressB, incarnationB := types.SplitStaleStorageKey(kvB.Key[1:])
+
+			return fmt.Sprintf("%v/%v\n%v/%v", addressA.Hex(), incarnationA, addressB.Hex(), incarnationB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixBankAllowance):
+			allowanceA := new(big.Int).SetBytes(kvA.Value)
+			allowanceB := new(big.Int).SetBytes(kvB.Value)
+
+			return fmt.Sprintf("%v\n%v", allowanceA, allowanceB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixCreateFactory):
+			factoryA := common.BytesToAddress(kvA.Key[1:]).Hex()
+			factoryB := common.BytesToAddress(kvB.Key[1:]).Hex()
+
+			return fmt.Sprintf("%v\n%v", factoryA, factoryB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixBlockHash):
+			hashA := common.BytesToHash(kvA.Value).Hex()
+			hashB := common.BytesToHash(kvB.Value).Hex()
+
+			return fmt.Sprintf("%v\n%v", hashA, hashB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixScheduledCall):
+			var callA, callB types.ScheduledCall
+			types.ModuleCdc.MustUnmarshal(kvA.Value, &callA)
+			types.ModuleCdc.MustUnmarshal(kvB.Value, &callB)
+
+			return fmt.Sprintf("%v\n%v", callA, callB)
+		case bytes.Equal(kvA.Key[:1], types.KeyScheduledCallID):
+			idA := sdk.BigEndianToUint64(kvA.Value)
+			idB := sdk.BigEndianToUint64(kvB.Value)
+
+			return fmt.Sprintf("%v\n%v", idA, idB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixScheduledCallQueue):
+			heightA, idA := sdk.BigEndianToUint64(kvA.Key[1:9]), sdk.BigEndianToUint64(kvA.Key[9:])
+			heightB, idB := sdk.BigEndianToUint64(kvB.Key[1:9]), sdk.BigEndianToUint64(kvB.Key[9:])
+
+			return fmt.Sprintf("%v/%v\n%v/%v", heightA, idA, heightB, idB)
 		default:
 			panic(fmt.Sprintf("invalid evm key prefix %X", kvA.Key[:1]))
 		}
@@ -0,0 +1,89 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+// Simulation parameter constants
+const (
+	ExtraEIPs           = "extra_eips"
+	AllowUnprotectedTxs = "allow_unprotected_txs"
+	EnableCreate        = "enable_create"
+	EnableCall          = "enable_call"
+)
+
+// GenExtraEIPs randomly enables a subset of the available extra EIPs, in order.
+func GenExtraEIPs(r *rand.Rand) []int64 {
+	var eips []int64
+	for _, eip := range types.AvailableExtraEIPs {
+		if r.Intn(2) == 0 {
+			eips = append(eips, eip)
+		}
+	}
+	return eips
+}
+
+// GenAllowUnprotectedTxs randomized AllowUnprotectedTxs
+func GenAllowUnprotectedTxs(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenEnableCreate randomized EnableCreate, contract creation is enabled with a 90%
+// probability so that the operations can exercise the EVM.
+func GenEnableCreate(r *rand.Rand) bool {
+	return r.Int63n(101) <= 90
+}
+
+// GenEnableCall randomized EnableCall, contract calls are enabled with a 90%
+// probability so that the operations can exercise the EVM.
+func GenEnableCall(r *rand.Rand) bool {
+	return r.Int63n(101) <= 90
+}
+
+// RandomizedGenState generates a random GenesisState for the evm module. The evm
+// denomination is the bond denomination so that the simulation accounts can pay for
+// the Ethereum transactions.
+func RandomizedGenState(simState *module.SimulationState) {
+	var extraEIPs []int64
+	simState.AppParams.GetOrGenerate(ExtraEIPs, &extraEIPs, simState.Rand, func(r *rand.Rand) { extraEIPs = GenExtraEIPs(r) })
+
+	var allowUnprotectedTxs bool
+	simState.AppParams.GetOrGenerate(AllowUnprotectedTxs, &allowUnprotectedTxs, simState.Rand, func(r *rand.Rand) { allowUnprotectedTxs = GenAllowUnprotectedTxs(r) })
+
+	var enableCreate bool
+	simState.AppParams.GetOrGenerate(EnableCreate, &enableCreate, simState.Rand, func(r *rand.Rand) { enableCreate = GenEnableCreate(r) })
+
+	var enableCall bool
+	simState.AppParams.GetOrGenerate(EnableCall, &enableCall, simState.Rand, func(r *rand.Rand) { enableCall = GenEnableCall(r) })
+
+	params := types.NewParams(simState.BondDenom, allowUnprotectedTxs, enableCreate, enableCall, types.DefaultChainConfig(), extraEIPs)
+	evmGenesis := types.NewGenesisState(params, []types.GenesisAccount{})
+
+	bz, err := json.MarshalIndent(&evmGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated evm parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(evmGenesis)
+}
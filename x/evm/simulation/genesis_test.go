@@ -0,0 +1,61 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/zeta-chain/ethermint/x/evm/simulation"
+	"github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+func newSimulationState(r *rand.Rand) *module.SimulationState {
+	return &module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		Rand:         r,
+		NumBonded:    3,
+		BondDenom:    sdk.DefaultBondDenom,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: sdkmath.NewInt(1000),
+		GenState:     make(map[string]json.RawMessage),
+	}
+}
+
+func TestRandomizedGenState(t *testing.T) {
+	for seed := int64(0); seed < 10; seed++ {
+		simState := newSimulationState(rand.New(rand.NewSource(seed)))
+		simulation.RandomizedGenState(simState)
+
+		var genesis types.GenesisState
+		simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genesis)
+
+		require.NoError(t, genesis.Validate())
+		require.Equal(t, sdk.DefaultBondDenom, genesis.Params.EvmDenom)
+		require.Equal(t, types.DefaultChainConfig(), genesis.Params.ChainConfig)
+		require.Empty(t, genesis.Accounts)
+	}
+}
+
+func TestRandomizedGenStateAppParams(t *testing.T) {
+	simState := newSimulationState(rand.New(rand.NewSource(1)))
+	simState.AppParams[simulation.ExtraEIPs] = json.RawMessage(`[2929]`)
+	simState.AppParams[simulation.EnableCreate] = json.RawMessage(`false`)
+
+	simulation.RandomizedGenState(simState)
+
+	var genesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genesis)
+
+	require.Equal(t, []int64{2929}, genesis.Params.ExtraEIPs)
+	require.False(t, genesis.Params.EnableCreate)
+}
@@ -0,0 +1,344 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package simulation
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/zeta-chain/ethermint/crypto/ethsecp256k1"
+	"github.com/zeta-chain/ethermint/x/evm/keeper"
+	"github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgEthSimpleTransfer = "op_weight_msg_eth_simple_transfer"
+	OpWeightMsgEthCreateContract = "op_weight_msg_eth_create_contract"
+)
+
+// Default simulation operation weights
+const (
+	DefaultWeightMsgEthSimpleTransfer = 100
+	DefaultWeightMsgEthCreateContract = 50
+)
+
+const (
+	// estimateGasCap is the gas cap used to estimate the gas of the simulated transactions
+	estimateGasCap uint64 = 25_000_000
+	// maxGasTip is the upper bound of the random priority fee of the simulated transactions
+	maxGasTip = 1_000
+	// maxCallDelay is the upper bound of the delay, in blocks, between a contract
+	// deployment and the call to the deployed contract
+	maxCallDelay = 10
+)
+
+// ErrNotEnoughBalance is returned when the simulation account can't pay for the
+// transaction fees.
+var ErrNotEnoughBalance = errors.New("not enough balance")
+
+// simulateContext holds the state shared by the steps building and delivering a
+// simulated Ethereum transaction.
+type simulateContext struct {
+	ctx      sdk.Context
+	bapp     *baseapp.BaseApp
+	rand     *rand.Rand
+	keeper   *keeper.Keeper
+	txConfig client.TxConfig
+}
+
+// WeightedOperations returns all the operations of the evm module with their
+// respective weights. Contract calls are scheduled as future operations of the
+// contract deployments so that they always target a deployed contract.
+func WeightedOperations(
+	appParams simtypes.AppParams, _ codec.JSONCodec, txConfig client.TxConfig, k *keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgEthSimpleTransfer int
+		weightMsgEthCreateContract int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgEthSimpleTransfer, &weightMsgEthSimpleTransfer, nil,
+		func(_ *rand.Rand) {
+			weightMsgEthSimpleTransfer = DefaultWeightMsgEthSimpleTransfer
+		},
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgEthCreateContract, &weightMsgEthCreateContract, nil,
+		func(_ *rand.Rand) {
+			weightMsgEthCreateContract = DefaultWeightMsgEthCreateContract
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgEthSimpleTransfer,
+			SimulateEthSimpleTransfer(txConfig, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgEthCreateContract,
+			SimulateEthCreateContract(txConfig, k),
+		),
+	}
+}
+
+// SimulateEthSimpleTransfer generates a MsgEthereumTx transferring a random amount
+// of the evm denomination from a random account to either an existing or a new
+// account.
+func SimulateEthSimpleTransfer(txConfig client.TxConfig, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, bapp *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		var recipient simtypes.Account
+		if r.Intn(2) == 0 {
+			recipient, _ = simtypes.RandomAcc(r, accs)
+		} else {
+			recipient = simtypes.RandomAccounts(r, 1)[0]
+		}
+
+		from := common.BytesToAddress(simAccount.Address)
+		to := common.BytesToAddress(recipient.Address)
+
+		sctx := &simulateContext{ctx, bapp, r, k, txConfig}
+		return SimulateEthTx(sctx, simAccount.PrivKey, &from, &to, nil, nil, nil)
+	}
+}
+
+// SimulateEthCreateContract generates a MsgEthereumTx deploying an ERC20 contract
+// owned by a random account. The deployment schedules a future operation that
+// transfers tokens from the owner to a random account.
+func SimulateEthCreateContract(txConfig client.TxConfig, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, bapp *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		params := k.GetParams(ctx)
+		if !params.EnableCreate {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEthereumTx, "contract creation is disabled"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		from := common.BytesToAddress(simAccount.Address)
+		if !params.IsCreateAllowed(from) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEthereumTx, "account is not allowed to deploy contracts"), nil, nil
+		}
+
+		supply := sdkmath.NewIntWithDecimal(1000, 18).BigInt()
+		ctorArgs, err := types.ERC20Contract.ABI.Pack("", from, supply)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEthereumTx, "failed to pack constructor arguments"), nil, err
+		}
+		data := make([]byte, 0, len(types.ERC20Contract.Bin)+len(ctorArgs))
+		data = append(append(data, types.ERC20Contract.Bin...), ctorArgs...)
+
+		var recipient simtypes.Account
+		if r.Intn(2) == 0 {
+			recipient, _ = simtypes.RandomAcc(r, accs)
+		} else {
+			recipient = simtypes.RandomAccounts(r, 1)[0]
+		}
+
+		contract := crypto.CreateAddress(from, k.GetNonce(ctx, from))
+		to := common.BytesToAddress(recipient.Address)
+		amount, err := simtypes.RandPositiveInt(r, sdkmath.NewIntFromBigInt(supply))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEthereumTx, "failed to generate the token amount"), nil, err
+		}
+
+		futureOps := []simtypes.FutureOperation{
+			{
+				BlockHeight: int(ctx.BlockHeight()) + r.Intn(maxCallDelay) + 1,
+				Op:          operationSimulateEthCallContract(txConfig, k, simAccount, contract, to, amount.BigInt()),
+			},
+		}
+
+		sctx := &simulateContext{ctx, bapp, r, k, txConfig}
+		return SimulateEthTx(sctx, simAccount.PrivKey, &from, nil, common.Big0, data, futureOps)
+	}
+}
+
+// operationSimulateEthCallContract generates a MsgEthereumTx calling the transfer
+// method of a previously deployed ERC20 contract.
+func operationSimulateEthCallContract(
+	txConfig client.TxConfig, k *keeper.Keeper, owner simtypes.Account, contract, to common.Address, amount *big.Int,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, bapp *baseapp.BaseApp, ctx sdk.Context, _ []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.GetParams(ctx).EnableCall {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEthereumTx, "contract calls are disabled"), nil, nil
+		}
+
+		data, err := types.ERC20Contract.ABI.Pack("transfer", to, amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEthereumTx, "failed to pack the transfer call"), nil, err
+		}
+
+		from := common.BytesToAddress(owner.Address)
+		sctx := &simulateContext{ctx, bapp, r, k, txConfig}
+		return SimulateEthTx(sctx, owner.PrivKey, &from, &contract, common.Big0, data, nil)
+	}
+}
+
+// SimulateEthTx builds a valid Ethereum transaction with random gas settings, signs
+// it with the account private key, wraps it into a cosmos transaction and delivers
+// it. A nil amount transfers a random amount of the sender's spendable balance.
+func SimulateEthTx(
+	sctx *simulateContext, privKey cryptotypes.PrivKey, from, to *common.Address, amount *big.Int, data []byte,
+	futureOps []simtypes.FutureOperation,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
+	if !ok {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEthereumTx, "account key is not an ethsecp256k1 key"), nil, nil
+	}
+
+	msg, err := CreateRandomValidEthTx(sctx, from, to, amount, data)
+	if errors.Is(err, ErrNotEnoughBalance) {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEthereumTx, "not enough balance"), nil, nil
+	}
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEthereumTx, "failed to create the transaction"), nil, err
+	}
+
+	if err := signEthTx(sctx, msg, ethPrivKey); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEthereumTx, "failed to sign the transaction"), nil, err
+	}
+
+	tx, err := msg.BuildTx(sctx.txConfig.NewTxBuilder(), sctx.keeper.GetParams(sctx.ctx).EvmDenom)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEthereumTx, "failed to build the transaction"), nil, err
+	}
+
+	if _, _, err := sctx.bapp.SimDeliver(sctx.txConfig.TxEncoder(), tx); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEthereumTx, "failed to deliver the transaction"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), futureOps, nil
+}
+
+// CreateRandomValidEthTx creates an unsigned MsgEthereumTx with a random gas limit
+// above the estimated gas and a random priority fee. Legacy and dynamic fee
+// transactions are both generated once the base fee is enabled.
+func CreateRandomValidEthTx(
+	sctx *simulateContext, from, to *common.Address, amount *big.Int, data []byte,
+) (*types.MsgEthereumTx, error) {
+	gas, err := EstimateGas(sctx, from, to, data)
+	if err != nil {
+		return nil, err
+	}
+	gasLimit := gas + uint64(sctx.rand.Int63n(int64(gas/10)+1))
+
+	chainID := sctx.keeper.ChainID()
+	ethCfg := sctx.keeper.GetParams(sctx.ctx).ChainConfig.EthereumConfig(chainID)
+	baseFee := sctx.keeper.GetBaseFee(sctx.ctx, ethCfg)
+
+	gasTipCap := big.NewInt(sctx.rand.Int63n(maxGasTip))
+	gasFeeCap := new(big.Int).Set(gasTipCap)
+	if baseFee != nil {
+		gasFeeCap.Add(gasFeeCap, baseFee)
+	}
+
+	if amount == nil {
+		amount, err = RandomTransferableAmount(sctx, *from, gasLimit, gasFeeCap)
+		if err != nil {
+			return nil, err
+		}
+	} else if new(big.Int).Mul(gasFeeCap, new(big.Int).SetUint64(gasLimit)).Cmp(sctx.keeper.GetBalance(sctx.ctx, *from)) > 0 {
+		return nil, ErrNotEnoughBalance
+	}
+
+	nonce := sctx.keeper.GetNonce(sctx.ctx, *from)
+
+	var msg *types.MsgEthereumTx
+	if baseFee == nil || sctx.rand.Intn(2) == 0 {
+		msg = types.NewTx(chainID, nonce, to, amount, gasLimit, gasFeeCap, nil, nil, data, nil)
+	} else {
+		msg = types.NewTx(chainID, nonce, to, amount, gasLimit, nil, gasFeeCap, gasTipCap, data, &ethtypes.AccessList{})
+	}
+	msg.From = from.Hex()
+
+	return msg, nil
+}
+
+// EstimateGas estimates the gas of the transaction on a cached context, so that the
+// estimation doesn't leave any trace in the simulated state.
+func EstimateGas(sctx *simulateContext, from, to *common.Address, data []byte) (uint64, error) {
+	args, err := json.Marshal(&types.TransactionArgs{To: to, From: from, Data: (*hexutil.Bytes)(&data)})
+	if err != nil {
+		return 0, err
+	}
+
+	cacheCtx, _ := sctx.ctx.CacheContext()
+	res, err := sctx.keeper.EstimateGas(cacheCtx, &types.EthCallRequest{
+		Args:   args,
+		GasCap: estimateGasCap,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return res.Gas, nil
+}
+
+// RandomTransferableAmount returns a random amount in the range [0, spendable), where
+// spendable is the balance left once the maximum transaction fee is paid.
+func RandomTransferableAmount(sctx *simulateContext, address common.Address, gasLimit uint64, gasFeeCap *big.Int) (*big.Int, error) {
+	balance := sctx.keeper.GetBalance(sctx.ctx, address)
+	feeLimit := new(big.Int).Mul(gasFeeCap, new(big.Int).SetUint64(gasLimit))
+	if feeLimit.Cmp(balance) > 0 {
+		return nil, ErrNotEnoughBalance
+	}
+
+	spendable := new(big.Int).Sub(balance, feeLimit)
+	if spendable.Sign() == 0 {
+		return spendable, nil
+	}
+
+	return new(big.Int).Rand(sctx.rand, spendable), nil
+}
+
+// signEthTx signs the transaction with the EIP-155 signer of the chain and populates
+// the signature fields of the message.
+func signEthTx(sctx *simulateContext, msg *types.MsgEthereumTx, privKey *ethsecp256k1.PrivKey) error {
+	key, err := privKey.ToECDSA()
+	if err != nil {
+		return err
+	}
+
+	signer := ethtypes.LatestSignerForChainID(sctx.keeper.ChainID())
+	tx, err := ethtypes.SignTx(msg.AsTransaction(), signer, key)
+	if err != nil {
+		return err
+	}
+
+	return msg.FromSignedEthereumTx(tx, sctx.keeper.ChainID())
+}
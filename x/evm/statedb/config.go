@@ -59,4 +59,10 @@ type EVMConfig struct {
 	ChainConfig *params.ChainConfig
 	CoinBase    common.Address
 	BaseFee     *big.Int
+	// StateOverrides are applied to the StateDB before the message execution,
+	// only used by `eth_call`/`eth_estimateGas`.
+	StateOverrides types.StateOverride
+	// BlockOverrides are applied to the EVM block context,
+	// only used by `eth_call`/`eth_estimateGas`.
+	BlockOverrides *types.BlockOverrides
 }
@@ -30,7 +30,10 @@ type Keeper interface {
 
 	// Read methods
 	GetAccount(ctx sdk.Context, addr common.Address) *Account
-	GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash
+	// GetIncarnation returns the current storage incarnation of the account
+	GetIncarnation(ctx sdk.Context, addr common.Address) uint64
+	// GetIncarnationState returns the storage slot of the given incarnation of the account
+	GetIncarnationState(ctx sdk.Context, addr common.Address, incarnation uint64, key common.Hash) common.Hash
 	GetCode(ctx sdk.Context, codeHash common.Hash) []byte
 	// the callback returns false to break early
 	ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool)
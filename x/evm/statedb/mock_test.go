@@ -69,6 +69,14 @@ func (k MockKeeper) GetState(ctx sdk.Context, addr common.Address, key common.Ha
 	return k.accounts[addr].states[key]
 }
 
+func (k MockKeeper) GetIncarnation(ctx sdk.Context, addr common.Address) uint64 {
+	return 0
+}
+
+func (k MockKeeper) GetIncarnationState(ctx sdk.Context, addr common.Address, _ uint64, key common.Hash) common.Hash {
+	return k.GetState(ctx, addr, key)
+}
+
 func (k MockKeeper) GetCode(ctx sdk.Context, codeHash common.Hash) []byte {
 	return k.codes[codeHash]
 }
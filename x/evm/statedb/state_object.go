@@ -85,6 +85,14 @@ type stateObject struct {
 	// flags
 	dirtyCode bool
 	suicided  bool
+	// fakeStorage is set when the whole storage is overridden, the original
+	// storage in the keeper is ignored afterwards.
+	fakeStorage bool
+
+	// incarnation is the storage incarnation of the account in the keeper, loaded with
+	// the first storage read
+	incarnation       uint64
+	incarnationLoaded bool
 }
 
 // newObject creates a state object.
@@ -228,12 +236,25 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
+	if s.fakeStorage {
+		return common.Hash{}
+	}
 	// If no live objects are available, load it from keeper
-	value := s.db.keeper.GetState(s.db.ctx, s.Address(), key)
+	value := s.db.keeper.GetIncarnationState(s.db.ctx, s.Address(), s.storageIncarnation(), key)
 	s.originStorage[key] = value
 	return value
 }
 
+// storageIncarnation returns the storage incarnation of the account in the keeper, it
+// doesn't change until the state is committed.
+func (s *stateObject) storageIncarnation() uint64 {
+	if !s.incarnationLoaded {
+		s.incarnation = s.db.keeper.GetIncarnation(s.db.ctx, s.Address())
+		s.incarnationLoaded = true
+	}
+	return s.incarnation
+}
+
 // GetState query the current state (including dirty state)
 func (s *stateObject) GetState(key common.Hash) common.Hash {
 	if value, dirty := s.dirtyStorage[key]; dirty {
@@ -261,3 +282,16 @@ func (s *stateObject) SetState(key common.Hash, value common.Hash) {
 func (s *stateObject) setState(key, value common.Hash) {
 	s.dirtyStorage[key] = value
 }
+
+// SetStorage replaces the entire state storage with the given one.
+//
+// After this function is called, all original state will be ignored and state
+// lookup only happens in the fake state storage.
+func (s *stateObject) SetStorage(storage map[common.Hash]common.Hash) {
+	s.originStorage = make(Storage, len(storage))
+	for key, value := range storage {
+		s.originStorage[key] = value
+	}
+	s.dirtyStorage = make(Storage)
+	s.fakeStorage = true
+}
@@ -23,6 +23,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -82,6 +83,13 @@ type StateDB struct {
 
 	// events emitted by native action
 	nativeEvents sdk.Events
+
+	// gas consumed by the native actions, charged to the transaction on top of the
+	// EVM execution gas
+	nativeGasUsed uint64
+
+	// error of the first call frame aborting the whole transaction
+	abortErr error
 }
 
 // New creates a new state from a given trie.
@@ -154,6 +162,34 @@ func (s *StateDB) SubRefund(gas uint64) {
 	s.refund -= gas
 }
 
+// ConsumeNativeGas adds the store gas consumed by a native action to the native gas
+// counter. It isn't reverted with the state, like the gas used by a reverted call.
+func (s *StateDB) ConsumeNativeGas(gas uint64) {
+	sum, overflow := math.SafeAdd(s.nativeGasUsed, gas)
+	if overflow {
+		sum = math.MaxUint64
+	}
+	s.nativeGasUsed = sum
+}
+
+// NativeGasUsed returns the store gas consumed by the native actions of the transaction.
+func (s *StateDB) NativeGasUsed() uint64 {
+	return s.nativeGasUsed
+}
+
+// Abort records the error of a call frame failing the whole transaction, only the first
+// error is kept. It is not reverted with the call frame.
+func (s *StateDB) Abort(err error) {
+	if s.abortErr == nil {
+		s.abortErr = err
+	}
+}
+
+// AbortErr returns the error of the first call frame aborting the transaction, if any.
+func (s *StateDB) AbortErr() error {
+	return s.abortErr
+}
+
 // Exist reports whether the given account address exists in the state.
 // Notably this also returns true for suicided accounts.
 func (s *StateDB) Exist(addr common.Address) bool {
@@ -388,6 +424,14 @@ func (s *StateDB) SubBalance(addr common.Address, amount *uint256.Int) {
 	}
 }
 
+// SetBalance sets the balance of account.
+func (s *StateDB) SetBalance(addr common.Address, amount *uint256.Int) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetBalance(amount)
+	}
+}
+
 // SetNonce sets the nonce of account.
 func (s *StateDB) SetNonce(addr common.Address, nonce uint64) {
 	stateObject := s.getOrNewStateObject(addr)
@@ -412,6 +456,16 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	}
 }
 
+// SetStorage replaces the entire storage for the specified account with given
+// storage. This function should only be used for debugging and the mutations
+// must be discarded afterwards.
+func (s *StateDB) SetStorage(addr common.Address, storage map[common.Hash]common.Hash) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetStorage(storage)
+	}
+}
+
 // SetTransientState sets transient storage for a given account. It
 // adds the change to the journal so that it can be rolled back
 // to its previous value if there is a revert.
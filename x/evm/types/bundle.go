@@ -0,0 +1,230 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"errors"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	_ sdk.Msg                            = &MsgEthereumTxBundle{}
+	_ codectypes.UnpackInterfacesMessage = MsgEthereumTxBundle{}
+)
+
+// NewMsgEthereumTxBundle returns a bundle of the signed Ethereum transactions, paid by
+// their senders.
+func NewMsgEthereumTxBundle(txs ...*MsgEthereumTx) *MsgEthereumTxBundle {
+	return &MsgEthereumTxBundle{Txs: txs}
+}
+
+// ValidateBasic implements the sdk.Msg interface. It validates the transactions of the
+// bundle and the fee payer fields, the fee payer signature is verified by the ante handler.
+func (m MsgEthereumTxBundle) ValidateBasic() error {
+	if len(m.Txs) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty bundle")
+	}
+
+	seen := make(map[string]bool, len(m.Txs))
+	for i, tx := range m.Txs {
+		if tx == nil {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "nil bundle tx %d", i)
+		}
+		if err := tx.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid bundle tx %d", i)
+		}
+		if seen[tx.Hash] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicated bundle tx %s", tx.Hash)
+		}
+		seen[tx.Hash] = true
+	}
+
+	if m.FeePayer == "" {
+		if len(m.FeePayerSignature) > 0 {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee payer signature without fee payer")
+		}
+		return nil
+	}
+
+	if !common.IsHexAddress(m.FeePayer) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid fee payer address %s", m.FeePayer)
+	}
+	if len(m.FeePayerSignature) != crypto.SignatureLength {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid fee payer signature length %d", len(m.FeePayerSignature))
+	}
+	return nil
+}
+
+// GetFeePayer returns the fee payer of the bundle, if any.
+func (m MsgEthereumTxBundle) GetFeePayer() (common.Address, bool) {
+	if m.FeePayer == "" {
+		return common.Address{}, false
+	}
+	return common.HexToAddress(m.FeePayer), true
+}
+
+// FeePayerHash returns the hash signed by the fee payer, committing to the chain id, the fee
+// payer and the transactions of the bundle in order.
+func (m MsgEthereumTxBundle) FeePayerHash(chainID *big.Int) common.Hash {
+	data := make([]byte, 0, common.HashLength+common.AddressLength+len(m.Txs)*common.HashLength)
+	data = append(data, math.U256Bytes(new(big.Int).Set(chainID))...)
+	data = append(data, common.HexToAddress(m.FeePayer).Bytes()...)
+	for _, tx := range m.Txs {
+		data = append(data, tx.AsTransaction().Hash().Bytes()...)
+	}
+	return crypto.Keccak256Hash(data)
+}
+
+// SignFeePayer signs the bundle hash with the key of the fee payer.
+func (m *MsgEthereumTxBundle) SignFeePayer(chainID *big.Int, keyringSigner keyring.Signer) error {
+	feePayer, ok := m.GetFeePayer()
+	if !ok {
+		return errors.New("fee payer not defined for bundle")
+	}
+
+	sig, _, err := keyringSigner.SignByAddress(sdk.AccAddress(feePayer.Bytes()), m.FeePayerHash(chainID).Bytes(), signing.SignMode_SIGN_MODE_TEXTUAL)
+	if err != nil {
+		return err
+	}
+
+	m.FeePayerSignature = sig
+	return nil
+}
+
+// VerifyFeePayer checks that the fee payer signature of the bundle is signed by the fee payer.
+func (m MsgEthereumTxBundle) VerifyFeePayer(chainID *big.Int) error {
+	feePayer, ok := m.GetFeePayer()
+	if !ok {
+		return nil
+	}
+
+	pubKey, err := crypto.SigToPub(m.FeePayerHash(chainID).Bytes(), m.FeePayerSignature)
+	if err != nil {
+		return errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "invalid fee payer signature: %s", err)
+	}
+
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != feePayer {
+		return errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "fee payer %s doesn't match signer %s", feePayer, signer)
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgEthereumTxBundle) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, tx := range m.Txs {
+		if err := tx.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BuildTx builds the ethereum cosmos tx of the bundle, with the fees and gas limit of all
+// its transactions.
+func (m *MsgEthereumTxBundle) BuildTx(b client.TxBuilder, evmDenom string) (authsigning.Tx, error) {
+	builder, ok := b.(authtx.ExtensionOptionsTxBuilder)
+	if !ok {
+		return nil, errors.New("unsupported builder")
+	}
+
+	option, err := codectypes.NewAnyWithValue(&ExtensionOptionsEthereumTx{})
+	if err != nil {
+		return nil, err
+	}
+
+	fees := make(sdk.Coins, 0)
+	gasLimit := uint64(0)
+	for _, tx := range m.Txs {
+		txData, err := UnpackTxData(tx.Data)
+		if err != nil {
+			return nil, err
+		}
+		if feeAmt := sdkmath.NewIntFromBigInt(txData.Fee()); feeAmt.Sign() > 0 {
+			fees = fees.Add(sdk.NewCoin(evmDenom, feeAmt))
+		}
+		gasLimit += tx.GetGas()
+	}
+
+	builder.SetExtensionOptions(option)
+
+	if err := builder.SetMsgs(m); err != nil {
+		return nil, err
+	}
+	builder.SetFeeAmount(fees)
+	builder.SetGasLimit(gasLimit)
+	return builder.GetTx(), nil
+}
+
+// UnwrapBundles returns the messages with the transactions of the bundles in place of the
+// bundles, the message index of a bundle transaction is its index in the returned slice.
+func UnwrapBundles(msgs []sdk.Msg) []sdk.Msg {
+	if _, ok := GetBundle(msgs); !ok {
+		return msgs
+	}
+
+	unwrapped := make([]sdk.Msg, 0, len(msgs))
+	for _, msg := range msgs {
+		bundle, ok := msg.(*MsgEthereumTxBundle)
+		if !ok {
+			unwrapped = append(unwrapped, msg)
+			continue
+		}
+		for _, tx := range bundle.Txs {
+			unwrapped = append(unwrapped, tx)
+		}
+	}
+	return unwrapped
+}
+
+// GetBundle returns the first bundle of the messages, if any.
+func GetBundle(msgs []sdk.Msg) (*MsgEthereumTxBundle, bool) {
+	for _, msg := range msgs {
+		if bundle, ok := msg.(*MsgEthereumTxBundle); ok {
+			return bundle, true
+		}
+	}
+	return nil, false
+}
+
+// GetBundleFeePayer returns the fee payer of the bundle of the messages, if any.
+func GetBundleFeePayer(msgs []sdk.Msg) (common.Address, bool) {
+	if bundle, ok := GetBundle(msgs); ok {
+		return bundle.GetFeePayer()
+	}
+	return common.Address{}, false
+}
+
+// ValidateBundleMsgs checks that a bundle is the only message of its transaction.
+func ValidateBundleMsgs(msgs []sdk.Msg) error {
+	if _, ok := GetBundle(msgs); ok && len(msgs) > 1 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "a bundle must be the only message of its transaction")
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package types_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/zeta-chain/ethermint/tests"
+	"github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+func (suite *MsgsTestSuite) signedBundleTx(nonce uint64) *types.MsgEthereumTx {
+	tx := types.NewTx(suite.chainID, nonce, &suite.to, nil, 100000, big.NewInt(1), nil, nil, nil, nil)
+	tx.From = suite.from.Hex()
+	suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(suite.chainID), suite.signer))
+	return tx
+}
+
+func (suite *MsgsTestSuite) TestMsgEthereumTxBundle_ValidateBasic() {
+	feePayer := tests.GenerateAddress()
+
+	testCases := []struct {
+		name     string
+		bundle   func() *types.MsgEthereumTxBundle
+		expError bool
+	}{
+		{
+			"valid bundle",
+			func() *types.MsgEthereumTxBundle {
+				return types.NewMsgEthereumTxBundle(suite.signedBundleTx(0), suite.signedBundleTx(1))
+			},
+			false,
+		},
+		{
+			"valid bundle with fee payer",
+			func() *types.MsgEthereumTxBundle {
+				bundle := types.NewMsgEthereumTxBundle(suite.signedBundleTx(0))
+				bundle.FeePayer = feePayer.Hex()
+				bundle.FeePayerSignature = make([]byte, 65)
+				return bundle
+			},
+			false,
+		},
+		{
+			"empty bundle",
+			func() *types.MsgEthereumTxBundle {
+				return types.NewMsgEthereumTxBundle()
+			},
+			true,
+		},
+		{
+			"invalid tx",
+			func() *types.MsgEthereumTxBundle {
+				tx := suite.signedBundleTx(0)
+				tx.Hash = ""
+				return types.NewMsgEthereumTxBundle(tx)
+			},
+			true,
+		},
+		{
+			"duplicated tx",
+			func() *types.MsgEthereumTxBundle {
+				tx := suite.signedBundleTx(0)
+				return types.NewMsgEthereumTxBundle(tx, tx)
+			},
+			true,
+		},
+		{
+			"fee payer signature without fee payer",
+			func() *types.MsgEthereumTxBundle {
+				bundle := types.NewMsgEthereumTxBundle(suite.signedBundleTx(0))
+				bundle.FeePayerSignature = make([]byte, 65)
+				return bundle
+			},
+			true,
+		},
+		{
+			"invalid fee payer",
+			func() *types.MsgEthereumTxBundle {
+				bundle := types.NewMsgEthereumTxBundle(suite.signedBundleTx(0))
+				bundle.FeePayer = invalidFromAddress
+				bundle.FeePayerSignature = make([]byte, 65)
+				return bundle
+			},
+			true,
+		},
+		{
+			"invalid fee payer signature length",
+			func() *types.MsgEthereumTxBundle {
+				bundle := types.NewMsgEthereumTxBundle(suite.signedBundleTx(0))
+				bundle.FeePayer = feePayer.Hex()
+				bundle.FeePayerSignature = make([]byte, 64)
+				return bundle
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.bundle().ValidateBasic()
+			if tc.expError {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgEthereumTxBundle_FeePayer() {
+	feePayer, privKey := tests.NewAddrKey()
+	signer := tests.NewSigner(privKey)
+
+	bundle := types.NewMsgEthereumTxBundle(suite.signedBundleTx(0), suite.signedBundleTx(1))
+	suite.Require().Error(bundle.SignFeePayer(suite.chainID, signer))
+	suite.Require().NoError(bundle.VerifyFeePayer(suite.chainID))
+
+	bundle.FeePayer = feePayer.Hex()
+	suite.Require().NoError(bundle.SignFeePayer(suite.chainID, signer))
+	suite.Require().NoError(bundle.ValidateBasic())
+	suite.Require().NoError(bundle.VerifyFeePayer(suite.chainID))
+
+	// the signature commits to the chain id and the transactions
+	suite.Require().Error(bundle.VerifyFeePayer(big.NewInt(2)))
+	reordered := types.NewMsgEthereumTxBundle(bundle.Txs[1], bundle.Txs[0])
+	reordered.FeePayer, reordered.FeePayerSignature = bundle.FeePayer, bundle.FeePayerSignature
+	suite.Require().Error(reordered.VerifyFeePayer(suite.chainID))
+
+	// the signature of another account is rejected
+	suite.Require().NoError(bundle.SignFeePayer(suite.chainID, signer))
+	bundle.FeePayer = suite.from.Hex()
+	suite.Require().Error(bundle.SignFeePayer(suite.chainID, signer))
+	suite.Require().Error(bundle.VerifyFeePayer(suite.chainID))
+}
+
+func (suite *MsgsTestSuite) TestUnwrapBundles() {
+	tx0, tx1 := suite.signedBundleTx(0), suite.signedBundleTx(1)
+	bundle := types.NewMsgEthereumTxBundle(tx0, tx1)
+
+	suite.Require().Equal([]sdk.Msg{tx0}, types.UnwrapBundles([]sdk.Msg{tx0}))
+	suite.Require().Equal([]sdk.Msg{tx0, tx1}, types.UnwrapBundles([]sdk.Msg{bundle}))
+
+	suite.Require().NoError(types.ValidateBundleMsgs([]sdk.Msg{tx0, tx1}))
+	suite.Require().NoError(types.ValidateBundleMsgs([]sdk.Msg{bundle}))
+	suite.Require().Error(types.ValidateBundleMsgs([]sdk.Msg{bundle, tx0}))
+}
+
+func (suite *MsgsTestSuite) TestMsgEthereumTxBundle_BuildTx() {
+	bundle := types.NewMsgEthereumTxBundle(suite.signedBundleTx(0), suite.signedBundleTx(1))
+
+	tx, err := bundle.BuildTx(suite.clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(200000), tx.GetGas())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("aphoton", 200000)), tx.GetFee())
+
+	signers, err := tx.GetSigners()
+	suite.Require().NoError(err)
+	suite.Require().Equal([][]byte{suite.from.Bytes()}, signers)
+
+	// the fee payer is a signer of the bundle
+	feePayer := tests.GenerateAddress()
+	bundle.FeePayer = feePayer.Hex()
+	tx, err = bundle.BuildTx(suite.clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+	suite.Require().NoError(err)
+	signers, err = tx.GetSigners()
+	suite.Require().NoError(err)
+	suite.Require().Equal([][]byte{suite.from.Bytes(), feePayer.Bytes()}, signers)
+}
@@ -46,6 +46,10 @@ func (cc ChainConfig) EthereumConfig(chainID *big.Int) *params.ChainConfig {
 		ArrowGlacierBlock:       getBlockValue(cc.ArrowGlacierBlock),
 		GrayGlacierBlock:        getBlockValue(cc.GrayGlacierBlock),
 		MergeNetsplitBlock:      getBlockValue(cc.MergeNetsplitBlock),
+		ShanghaiTime:            getTimeValue(cc.ShanghaiTime),
+		CancunTime:              getTimeValue(cc.CancunTime),
+		PragueTime:              getTimeValue(cc.PragueTime),
+		VerkleTime:              getTimeValue(cc.VerkleTime),
 		TerminalTotalDifficulty: nil,
 		Ethash:                  nil,
 		Clique:                  nil,
@@ -53,6 +57,7 @@ func (cc ChainConfig) EthereumConfig(chainID *big.Int) *params.ChainConfig {
 }
 
 // DefaultChainConfig returns default evm parameters.
+// The time based forks are not scheduled by default.
 func DefaultChainConfig() ChainConfig {
 	homesteadBlock := sdkmath.ZeroInt()
 	daoForkBlock := sdkmath.ZeroInt()
@@ -103,8 +108,18 @@ func getBlockValue(block *sdkmath.Int) *big.Int {
 	return block.BigInt()
 }
 
+func getTimeValue(time *sdkmath.Int) *uint64 {
+	if time == nil || time.IsNegative() || !time.IsUint64() {
+		return nil
+	}
+
+	value := time.Uint64()
+	return &value
+}
+
 // Validate performs a basic validation of the ChainConfig params. The function will return an error
-// if any of the block values is uninitialized (i.e nil) or if the EIP150Hash is an invalid hash.
+// if any of the block or time values is invalid, if the EIP150Hash is an invalid hash or if the
+// forks are not scheduled in order.
 func (cc ChainConfig) Validate() error {
 	if err := validateBlock(cc.HomesteadBlock); err != nil {
 		return errorsmod.Wrap(err, "homesteadBlock")
@@ -160,6 +175,18 @@ func (cc ChainConfig) Validate() error {
 	if err := validateBlock(cc.CancunBlock); err != nil {
 		return errorsmod.Wrap(err, "CancunBlock")
 	}
+	if err := validateTime(cc.ShanghaiTime); err != nil {
+		return errorsmod.Wrap(err, "ShanghaiTime")
+	}
+	if err := validateTime(cc.CancunTime); err != nil {
+		return errorsmod.Wrap(err, "CancunTime")
+	}
+	if err := validateTime(cc.PragueTime); err != nil {
+		return errorsmod.Wrap(err, "PragueTime")
+	}
+	if err := validateTime(cc.VerkleTime); err != nil {
+		return errorsmod.Wrap(err, "VerkleTime")
+	}
 	// NOTE: chain ID is not needed to check config order
 	if err := cc.EthereumConfig(nil).CheckConfigForkOrder(); err != nil {
 		return errorsmod.Wrap(err, "invalid config fork order")
@@ -189,3 +216,18 @@ func validateBlock(block *sdkmath.Int) error {
 
 	return nil
 }
+
+func validateTime(time *sdkmath.Int) error {
+	// nil value means that the fork has not been scheduled
+	if time == nil {
+		return nil
+	}
+
+	if time.IsNegative() || !time.IsUint64() {
+		return errorsmod.Wrapf(
+			ErrInvalidChainConfig, "time value must be a valid unix timestamp: %s", time,
+		)
+	}
+
+	return nil
+}
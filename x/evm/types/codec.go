@@ -38,7 +38,13 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/MsgUpdateParams"
+	updateParamsName    = "ethermint/MsgUpdateParams"
+	callContractName    = "ethermint/MsgCallContract"
+	deployContractName  = "ethermint/MsgDeployContract"
+	registerCallName    = "ethermint/MsgRegisterScheduledCall"
+	cancelCallName      = "ethermint/MsgCancelScheduledCall"
+	approveCallName     = "ethermint/MsgApproveScheduledCall"
+	updateFactoriesName = "ethermint/MsgUpdateCreateFactories"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -52,6 +58,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
+		&MsgEthereumTxBundle{},
 	)
 	registry.RegisterImplementations(
 		(*tx.TxExtensionOptionI)(nil),
@@ -61,6 +68,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgCallContract{},
+		&MsgDeployContract{},
+		&MsgRegisterScheduledCall{},
+		&MsgCancelScheduledCall{},
+		&MsgApproveScheduledCall{},
+		&MsgUpdateCreateFactories{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
@@ -108,4 +121,10 @@ func UnpackTxData(cdcAny *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgCallContract{}, callContractName, nil)
+	cdc.RegisterConcrete(&MsgDeployContract{}, deployContractName, nil)
+	cdc.RegisterConcrete(&MsgRegisterScheduledCall{}, registerCallName, nil)
+	cdc.RegisterConcrete(&MsgCancelScheduledCall{}, cancelCallName, nil)
+	cdc.RegisterConcrete(&MsgApproveScheduledCall{}, approveCallName, nil)
+	cdc.RegisterConcrete(&MsgUpdateCreateFactories{}, updateFactoriesName, nil)
 }
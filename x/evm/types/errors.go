@@ -49,6 +49,12 @@ const (
 	codeErrGasOverflow
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
+	codeErrUnknownPrecompile
+	codeErrCreateNotAllowed
+	codeErrBlockedAddress
+	codeErrScheduledCallNotFound
+	codeErrBundleTxFailed
+	codeErrInsufficientScheduledCallAllowance
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -116,6 +122,27 @@ var (
 
 	// ErrInvalidGasLimit returns an error if gas limit value is invalid
 	ErrInvalidGasLimit = errorsmod.Register(ModuleName, codeErrInvalidGasLimit, "invalid gas limit")
+
+	// ErrUnknownPrecompile returns an error if a precompiled contract is not registered on the keeper
+	ErrUnknownPrecompile = errorsmod.Register(ModuleName, codeErrUnknownPrecompile, "unknown precompiled contract")
+
+	// ErrCreateNotAllowed returns an error if the address is not in the create allowlist
+	ErrCreateNotAllowed = errorsmod.Register(ModuleName, codeErrCreateNotAllowed, "address is not allowed to create contracts")
+
+	// ErrBlockedAddress returns an error if the address is in the blocked addresses
+	ErrBlockedAddress = errorsmod.Register(ModuleName, codeErrBlockedAddress, "address is blocked")
+
+	// ErrScheduledCallNotFound returns an error if the scheduled call doesn't exist
+	ErrScheduledCallNotFound = errorsmod.Register(ModuleName, codeErrScheduledCallNotFound, "scheduled call not found")
+
+	// ErrBundleTxFailed returns an error if a transaction of a bundle fails, reverting the whole bundle
+	ErrBundleTxFailed = errorsmod.Register(ModuleName, codeErrBundleTxFailed, "bundled transaction failed")
+
+	// ErrInsufficientScheduledCallAllowance returns an error if the execution fees of a scheduled call exceed
+	// the allowance approved by its payer
+	ErrInsufficientScheduledCallAllowance = errorsmod.Register(
+		ModuleName, codeErrInsufficientScheduledCallAllowance, "insufficient scheduled call allowance",
+	)
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
@@ -20,6 +20,9 @@ const (
 	EventTypeEthereumTx = TypeMsgEthereumTx
 	EventTypeBlockBloom = "block_bloom"
 	EventTypeTxLog      = "tx_log"
+	EventTypeCallEVM    = "call_evm"
+
+	EventTypeScheduledCall = "scheduled_call"
 
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
@@ -34,6 +37,11 @@ const (
 	AttributeKeyTxNonce = "txNonce"
 	AttributeKeyTxData  = "txData"
 
+	AttributeKeyScheduledCallID       = "id"
+	AttributeKeyScheduledCallFee      = "fee"
+	AttributeKeyScheduledCallError    = "error"
+	AttributeKeyScheduledCallDisabled = "disabled"
+
 	// tx failed in eth vm execution
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
 	AttributeValueCategory       = ModuleName
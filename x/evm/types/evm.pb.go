@@ -40,6 +40,34 @@ type Params struct {
 	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
 	// signed) transactions can be executed on the state machine.
 	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
+	// active_precompiles defines the hex addresses of the registered precompiled
+	// contracts that are active in the EVM.
+	ActivePrecompiles []string `protobuf:"bytes,7,rep,name=active_precompiles,json=activePrecompiles,proto3" json:"active_precompiles,omitempty" yaml:"active_precompiles"`
+	// create_allowlist defines the hex addresses allowed to deploy contracts, either
+	// with a contract creation transaction or with CREATE/CREATE2 for factory
+	// contracts. An empty list allows every address to deploy contracts.
+	CreateAllowlist []string `protobuf:"bytes,8,rep,name=create_allowlist,json=createAllowlist,proto3" json:"create_allowlist,omitempty" yaml:"create_allowlist"`
+	// blocked_addresses defines the hex addresses that can't send or receive calls
+	// and value transfers in the EVM, including the internal calls.
+	BlockedAddresses []string `protobuf:"bytes,9,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses,omitempty" yaml:"blocked_addresses"`
+	// fee_denoms defines the alternative denominations accepted to pay the EVM
+	// transaction fees when the sender balance in evm_denom doesn't cover them.
+	// They are tried in order.
+	FeeDenoms []FeeDenom `protobuf:"bytes,10,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms" yaml:"fee_denoms"`
+	// block_gas_limit defines the gas budget of the EVM transactions in a block,
+	// capped by the consensus params max gas. Zero uses the consensus params max gas.
+	BlockGasLimit uint64 `protobuf:"varint,11,opt,name=block_gas_limit,json=blockGasLimit,proto3" json:"block_gas_limit,omitempty" yaml:"block_gas_limit"`
+	// create_factory_admin is the bech32 address of the account managing the factory
+	// contracts allowed to deploy contracts with CREATE/CREATE2 on top of the
+	// create_allowlist. An empty address disables the factory management.
+	CreateFactoryAdmin string `protobuf:"bytes,12,opt,name=create_factory_admin,json=createFactoryAdmin,proto3" json:"create_factory_admin,omitempty" yaml:"create_factory_admin"`
+	// scheduled_calls_gas_limit defines the total gas limit of the scheduled calls
+	// executed at the end of a block, the due calls exceeding it are deferred to the
+	// next blocks. Zero disables the execution of the scheduled calls.
+	ScheduledCallsGasLimit uint64 `protobuf:"varint,13,opt,name=scheduled_calls_gas_limit,json=scheduledCallsGasLimit,proto3" json:"scheduled_calls_gas_limit,omitempty" yaml:"scheduled_calls_gas_limit"`
+	// bank_precompile_denoms defines the bank denoms exposed through the ERC-20
+	// interface by a bank precompiled contract, at the address derived from the denom.
+	BankPrecompileDenoms []string `protobuf:"bytes,14,rep,name=bank_precompile_denoms,json=bankPrecompileDenoms,proto3" json:"bank_precompile_denoms,omitempty" yaml:"bank_precompile_denoms"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -117,6 +145,112 @@ func (m *Params) GetAllowUnprotectedTxs() bool {
 	return false
 }
 
+func (m *Params) GetActivePrecompiles() []string {
+	if m != nil {
+		return m.ActivePrecompiles
+	}
+	return nil
+}
+
+func (m *Params) GetCreateAllowlist() []string {
+	if m != nil {
+		return m.CreateAllowlist
+	}
+	return nil
+}
+
+func (m *Params) GetBlockedAddresses() []string {
+	if m != nil {
+		return m.BlockedAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetBlockGasLimit() uint64 {
+	if m != nil {
+		return m.BlockGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetCreateFactoryAdmin() string {
+	if m != nil {
+		return m.CreateFactoryAdmin
+	}
+	return ""
+}
+
+func (m *Params) GetScheduledCallsGasLimit() uint64 {
+	if m != nil {
+		return m.ScheduledCallsGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetBankPrecompileDenoms() []string {
+	if m != nil {
+		return m.BankPrecompileDenoms
+	}
+	return nil
+}
+
+// FeeDenom defines an alternative denomination accepted to pay the EVM
+// transaction fees and its conversion rate.
+type FeeDenom struct {
+	// denom is the alternative fee denomination
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the amount of denom equivalent to one unit of evm_denom, it is used
+	// when no oracle rate is available for the denomination.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -159,13 +293,21 @@ type ChainConfig struct {
 	ShanghaiBlock *cosmossdk_io_math.Int `protobuf:"bytes,22,opt,name=shanghai_block,json=shanghaiBlock,proto3,customtype=cosmossdk.io/math.Int" json:"shanghai_block,omitempty" yaml:"shanghai_block"`
 	// cancun_block switch block (nil = no fork, 0 = already on cancun)
 	CancunBlock *cosmossdk_io_math.Int `protobuf:"bytes,23,opt,name=cancun_block,json=cancunBlock,proto3,customtype=cosmossdk.io/math.Int" json:"cancun_block,omitempty" yaml:"cancun_block"`
+	// shanghai_time: Shanghai switch time (nil = no fork, 0 = already on shanghai)
+	ShanghaiTime *cosmossdk_io_math.Int `protobuf:"bytes,24,opt,name=shanghai_time,json=shanghaiTime,proto3,customtype=cosmossdk.io/math.Int" json:"shanghai_time,omitempty" yaml:"shanghai_time"`
+	// cancun_time: Cancun switch time (nil = no fork, 0 = already on cancun)
+	CancunTime *cosmossdk_io_math.Int `protobuf:"bytes,25,opt,name=cancun_time,json=cancunTime,proto3,customtype=cosmossdk.io/math.Int" json:"cancun_time,omitempty" yaml:"cancun_time"`
+	// prague_time: Prague switch time (nil = no fork, 0 = already on prague)
+	PragueTime *cosmossdk_io_math.Int `protobuf:"bytes,26,opt,name=prague_time,json=pragueTime,proto3,customtype=cosmossdk.io/math.Int" json:"prague_time,omitempty" yaml:"prague_time"`
+	// verkle_time: Verkle switch time (nil = no fork, 0 = already on verkle)
+	VerkleTime *cosmossdk_io_math.Int `protobuf:"bytes,27,opt,name=verkle_time,json=verkleTime,proto3,customtype=cosmossdk.io/math.Int" json:"verkle_time,omitempty" yaml:"verkle_time"`
 }
 
 func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+	return fileDescriptor_d21ecc92c8c8583e, []int{2}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,7 +362,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+	return fileDescriptor_d21ecc92c8c8583e, []int{3}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,7 +419,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+	return fileDescriptor_d21ecc92c8c8583e, []int{4}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,7 +495,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+	return fileDescriptor_d21ecc92c8c8583e, []int{5}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,7 +610,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -509,7 +651,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{6}
+	return fileDescriptor_d21ecc92c8c8583e, []int{7}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +711,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{7}
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -675,8 +817,143 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 	return ""
 }
 
+// ScheduledCall defines a contract call registered by governance and executed
+// from the EVM address of the module authority at the end of every interval
+// blocks.
+type ScheduledCall struct {
+	// id is the unique identifier of the scheduled call.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// contract is the hex address of the called contract.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// data is the call data.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// gas_limit is the gas limit of each execution.
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// payer is the bech32 address of the account paying the gas used by each
+	// execution at the block base fee, up to the allowance it has approved.
+	Payer string `protobuf:"bytes,5,opt,name=payer,proto3" json:"payer,omitempty"`
+	// interval is the number of blocks between two executions.
+	Interval uint64 `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	// next_height is the height of the next execution.
+	NextHeight uint64 `protobuf:"varint,7,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// max_failures is the number of consecutive failed executions after which
+	// the call is disabled, it must be positive.
+	MaxFailures uint64 `protobuf:"varint,8,opt,name=max_failures,json=maxFailures,proto3" json:"max_failures,omitempty"`
+	// failures is the number of consecutive failed executions.
+	Failures uint64 `protobuf:"varint,9,opt,name=failures,proto3" json:"failures,omitempty"`
+	// disabled is true if the call is no longer executed.
+	Disabled bool `protobuf:"varint,10,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// allowance is the amount of evm_denom the payer has approved to pay the
+	// executions, it is decreased by the fees of each execution.
+	Allowance cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=allowance,proto3,customtype=cosmossdk.io/math.Int" json:"allowance"`
+}
+
+func (m *ScheduledCall) Reset()         { *m = ScheduledCall{} }
+func (m *ScheduledCall) String() string { return proto.CompactTextString(m) }
+func (*ScheduledCall) ProtoMessage()    {}
+func (*ScheduledCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{9}
+}
+func (m *ScheduledCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledCall.Merge(m, src)
+}
+func (m *ScheduledCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledCall proto.InternalMessageInfo
+
+func (m *ScheduledCall) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledCall) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ScheduledCall) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ScheduledCall) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *ScheduledCall) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *ScheduledCall) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *ScheduledCall) GetNextHeight() uint64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+func (m *ScheduledCall) GetMaxFailures() uint64 {
+	if m != nil {
+		return m.MaxFailures
+	}
+	return 0
+}
+
+func (m *ScheduledCall) GetFailures() uint64 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *ScheduledCall) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
+	proto.RegisterType((*FeeDenom)(nil), "ethermint.evm.v1.FeeDenom")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
 	proto.RegisterType((*State)(nil), "ethermint.evm.v1.State")
 	proto.RegisterType((*TransactionLogs)(nil), "ethermint.evm.v1.TransactionLogs")
@@ -684,113 +961,146 @@ func init() {
 	proto.RegisterType((*TxResult)(nil), "ethermint.evm.v1.TxResult")
 	proto.RegisterType((*AccessTuple)(nil), "ethermint.evm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "ethermint.evm.v1.TraceConfig")
+	proto.RegisterType((*ScheduledCall)(nil), "ethermint.evm.v1.ScheduledCall")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1603 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0x4d, 0x6f, 0xe3, 0xc6,
-	0x19, 0xb6, 0x2d, 0xda, 0xa6, 0x46, 0xb2, 0xc4, 0x1d, 0xcb, 0x8e, 0xb2, 0x8b, 0x9a, 0x2e, 0x4f,
-	0x2e, 0x9a, 0xd8, 0x6b, 0x6f, 0xdd, 0x2c, 0x12, 0xb4, 0xc5, 0x6a, 0xd7, 0x69, 0xed, 0x6e, 0xd3,
-	0xc5, 0xac, 0x83, 0xa2, 0x45, 0x0b, 0x62, 0x44, 0x4e, 0x28, 0xc6, 0x24, 0x47, 0x98, 0x19, 0x6a,
-	0xa5, 0xfc, 0x82, 0x16, 0xbd, 0xf4, 0x27, 0xe4, 0xe7, 0x04, 0x3d, 0xe5, 0x58, 0xf4, 0x40, 0x14,
-	0xde, 0x9b, 0x8f, 0xba, 0x17, 0x28, 0xe6, 0x43, 0xd4, 0x87, 0x5d, 0x45, 0x27, 0xcd, 0xf3, 0x7e,
-	0x3c, 0xcf, 0xcc, 0x3b, 0x2f, 0x35, 0x33, 0xe0, 0x31, 0x11, 0x3d, 0xc2, 0xd2, 0x38, 0x13, 0x27,
-	0x64, 0x90, 0x9e, 0x0c, 0x4e, 0xe5, 0xcf, 0x71, 0x9f, 0x51, 0x41, 0xa1, 0x53, 0xfa, 0x8e, 0xa5,
-	0x71, 0x70, 0xfa, 0xb8, 0x15, 0xd1, 0x88, 0x2a, 0xe7, 0x89, 0x1c, 0xe9, 0x38, 0xef, 0x6f, 0x15,
-	0xb0, 0xf5, 0x06, 0x33, 0x9c, 0x72, 0x78, 0x0a, 0xaa, 0x64, 0x90, 0xfa, 0x21, 0xc9, 0x68, 0xda,
-	0x5e, 0x3f, 0x5c, 0x3f, 0xaa, 0x76, 0x5a, 0xe3, 0xc2, 0x75, 0x46, 0x38, 0x4d, 0x3e, 0xf5, 0x4a,
-	0x97, 0x87, 0x6c, 0x32, 0x48, 0x5f, 0xc9, 0x21, 0xfc, 0x05, 0xd8, 0x21, 0x19, 0xee, 0x26, 0xc4,
-	0x0f, 0x18, 0xc1, 0x82, 0xb4, 0x37, 0x0e, 0xd7, 0x8f, 0xec, 0x4e, 0x7b, 0x5c, 0xb8, 0x2d, 0x93,
-	0x36, 0xeb, 0xf6, 0x50, 0x5d, 0xe3, 0x97, 0x0a, 0xc2, 0x4f, 0x40, 0x6d, 0xe2, 0xc7, 0x49, 0xd2,
-	0xae, 0xa8, 0xe4, 0xfd, 0x71, 0xe1, 0xc2, 0xf9, 0x64, 0x9c, 0x24, 0x1e, 0x02, 0x26, 0x15, 0x27,
-	0x09, 0x7c, 0x01, 0x00, 0x19, 0x0a, 0x86, 0x7d, 0x12, 0xf7, 0x79, 0xdb, 0x3a, 0xac, 0x1c, 0x55,
-	0x3a, 0xde, 0x6d, 0xe1, 0x56, 0x2f, 0xa4, 0xf5, 0xe2, 0xf2, 0x0d, 0x1f, 0x17, 0xee, 0x23, 0x43,
-	0x52, 0x06, 0x7a, 0xa8, 0xaa, 0xc0, 0x45, 0xdc, 0xe7, 0xf0, 0x2f, 0xa0, 0x1e, 0xf4, 0x70, 0x9c,
-	0xf9, 0x01, 0xcd, 0xbe, 0x8a, 0xa3, 0xf6, 0xe6, 0xe1, 0xfa, 0x51, 0xed, 0xec, 0x47, 0xc7, 0x8b,
-	0x75, 0x3b, 0x7e, 0x29, 0xa3, 0x5e, 0xaa, 0xa0, 0xce, 0x93, 0xef, 0x0a, 0x77, 0x6d, 0x5c, 0xb8,
-	0xbb, 0x9a, 0x7a, 0x96, 0xc0, 0x43, 0xb5, 0x60, 0x1a, 0x09, 0xcf, 0xc0, 0x1e, 0x4e, 0x12, 0xfa,
-	0xce, 0xcf, 0x33, 0x59, 0x68, 0x12, 0x08, 0x12, 0xfa, 0x62, 0xc8, 0xdb, 0x5b, 0x72, 0x91, 0x68,
-	0x57, 0x39, 0xbf, 0x9c, 0xfa, 0xae, 0x87, 0xdc, 0xfb, 0x6f, 0x03, 0xd4, 0x66, 0xd4, 0xe0, 0x9f,
-	0x41, 0xb3, 0x47, 0x53, 0xc2, 0x05, 0xc1, 0xa1, 0xdf, 0x4d, 0x68, 0x70, 0x63, 0xb6, 0xe5, 0xd9,
-	0xbf, 0x0b, 0x77, 0x2f, 0xa0, 0x3c, 0xa5, 0x9c, 0x87, 0x37, 0xc7, 0x31, 0x3d, 0x49, 0xb1, 0xe8,
-	0x1d, 0x5f, 0x66, 0x62, 0x5c, 0xb8, 0xfb, 0x7a, 0x6e, 0x0b, 0x99, 0x1e, 0x6a, 0x94, 0x96, 0x8e,
-	0x34, 0xc0, 0x1e, 0x68, 0x84, 0x98, 0xfa, 0x5f, 0x51, 0x76, 0x63, 0xc8, 0x37, 0x14, 0x79, 0xe7,
-	0xff, 0x92, 0xdf, 0x16, 0x6e, 0xfd, 0xd5, 0x8b, 0xdf, 0x7f, 0x4e, 0xd9, 0x8d, 0xa2, 0x18, 0x17,
-	0xee, 0x9e, 0x16, 0x9b, 0x27, 0xf2, 0x50, 0x3d, 0xc4, 0xb4, 0x0c, 0x83, 0x7f, 0x00, 0x4e, 0x19,
-	0xc0, 0xf3, 0x7e, 0x9f, 0x32, 0x61, 0xf6, 0xfa, 0xe3, 0xdb, 0xc2, 0x6d, 0x18, 0xca, 0xb7, 0xda,
-	0x33, 0x2e, 0xdc, 0x0f, 0x16, 0x48, 0x4d, 0x8e, 0x87, 0x1a, 0x86, 0xd6, 0x84, 0xc2, 0x2e, 0xa8,
-	0x93, 0xb8, 0x7f, 0x7a, 0xfe, 0xd4, 0x2c, 0xc0, 0x52, 0x0b, 0xf8, 0xd5, 0xb2, 0x05, 0xd4, 0x2e,
-	0x2e, 0xdf, 0x9c, 0x9e, 0x3f, 0x9d, 0xcc, 0xdf, 0x6c, 0xe4, 0x2c, 0x8b, 0x87, 0x6a, 0x1a, 0xea,
-	0xc9, 0x5f, 0x02, 0x03, 0xfd, 0x1e, 0xe6, 0x3d, 0xd5, 0x26, 0xd5, 0xce, 0xd1, 0x6d, 0xe1, 0x02,
-	0xcd, 0xf4, 0x1b, 0xcc, 0x7b, 0xd3, 0xaa, 0x77, 0x47, 0xdf, 0xe0, 0x4c, 0xc4, 0x79, 0x3a, 0xe1,
-	0x02, 0x3a, 0x59, 0x46, 0x95, 0xd3, 0x3d, 0x37, 0xd3, 0xdd, 0x5a, 0x75, 0xba, 0xe7, 0x0f, 0x4d,
-	0xf7, 0x7c, 0x7e, 0xba, 0x3a, 0xa6, 0xd4, 0x78, 0x6e, 0x34, 0xb6, 0x57, 0xd5, 0x78, 0xfe, 0x90,
-	0xc6, 0xf3, 0x79, 0x0d, 0x1d, 0x23, 0xfb, 0x72, 0x61, 0x9d, 0x6d, 0x7b, 0xe5, 0xbe, 0xbc, 0x57,
-	0xa1, 0x46, 0x69, 0xd1, 0xec, 0x37, 0xa0, 0x15, 0xd0, 0x8c, 0x0b, 0x69, 0xcb, 0x68, 0x3f, 0x21,
-	0x46, 0xa2, 0xaa, 0x24, 0x9e, 0x2f, 0x93, 0x78, 0x62, 0x3e, 0xcb, 0x07, 0xd2, 0x3d, 0xb4, 0x3b,
-	0x6f, 0xd6, 0x62, 0x3e, 0x70, 0xfa, 0x44, 0x10, 0xc6, 0xbb, 0x39, 0x8b, 0x8c, 0x10, 0x50, 0x42,
-	0x3f, 0x5b, 0x26, 0x64, 0x3a, 0x74, 0x31, 0xd5, 0x43, 0xcd, 0xa9, 0x49, 0x0b, 0xfc, 0x11, 0x34,
-	0x62, 0xa9, 0xda, 0xcd, 0x13, 0x43, 0x5f, 0x53, 0xf4, 0x67, 0xcb, 0xe8, 0xcd, 0x57, 0x35, 0x9f,
-	0xe8, 0xa1, 0x9d, 0x89, 0x41, 0x53, 0x87, 0x00, 0xa6, 0x79, 0xcc, 0xfc, 0x28, 0xc1, 0x41, 0x4c,
-	0x98, 0xa1, 0xaf, 0x2b, 0xfa, 0x9f, 0x2f, 0xa3, 0xff, 0x50, 0xd3, 0xdf, 0x4f, 0xf6, 0x90, 0x23,
-	0x8d, 0xbf, 0xd6, 0x36, 0xad, 0xf2, 0x16, 0xd4, 0xbb, 0x84, 0x25, 0x71, 0x66, 0xf8, 0x77, 0x14,
-	0xff, 0xd3, 0x65, 0xfc, 0xa6, 0x83, 0x66, 0xd3, 0x3c, 0x54, 0xd3, 0xb0, 0x24, 0x4d, 0x68, 0x16,
-	0xd2, 0x09, 0xe9, 0xa3, 0x95, 0x49, 0x67, 0xd3, 0x3c, 0x54, 0xd3, 0x50, 0x93, 0x46, 0x60, 0x17,
-	0x33, 0x46, 0xdf, 0x2d, 0x14, 0x04, 0x2a, 0xee, 0x4f, 0x96, 0x71, 0x3f, 0xd6, 0xdc, 0x0f, 0x64,
-	0x7b, 0xe8, 0x91, 0xb2, 0xce, 0x95, 0x24, 0x04, 0x30, 0x62, 0x78, 0xb4, 0xa0, 0xd3, 0x5a, 0xb9,
-	0xf0, 0xf7, 0x93, 0x3d, 0xe4, 0x48, 0xe3, 0x9c, 0xca, 0xd7, 0xa0, 0x95, 0x12, 0x16, 0x11, 0x3f,
-	0x23, 0x82, 0xf7, 0x93, 0x58, 0x18, 0x9d, 0xbd, 0x95, 0xbf, 0x83, 0x87, 0xd2, 0x3d, 0x04, 0x95,
-	0xf9, 0x0b, 0x63, 0x2d, 0xbb, 0x94, 0xf7, 0x70, 0x16, 0xf5, 0x70, 0x6c, 0x54, 0xf6, 0x57, 0xee,
-	0xd2, 0xf9, 0x44, 0x0f, 0xed, 0x4c, 0x0c, 0xe5, 0x56, 0x07, 0x38, 0x0b, 0xf2, 0xc9, 0x56, 0x7f,
-	0xb0, 0xf2, 0x56, 0xcf, 0xa6, 0xc9, 0xd3, 0x55, 0x41, 0x45, 0x7a, 0x65, 0xd9, 0x0d, 0xa7, 0x79,
-	0x65, 0xd9, 0x4d, 0xc7, 0xb9, 0xb2, 0x6c, 0xc7, 0x79, 0x74, 0x65, 0xd9, 0xbb, 0x4e, 0x0b, 0xed,
-	0x8c, 0x68, 0x42, 0xfd, 0xc1, 0x33, 0x9d, 0x84, 0x6a, 0xe4, 0x1d, 0xe6, 0xe6, 0x8f, 0x06, 0x35,
-	0x02, 0x2c, 0x70, 0x32, 0xe2, 0xa6, 0x10, 0xc8, 0xd1, 0xe5, 0x99, 0x39, 0xb6, 0x4e, 0xc0, 0xe6,
-	0x5b, 0x21, 0xef, 0x25, 0x0e, 0xa8, 0xdc, 0x90, 0x91, 0x3e, 0x6c, 0x91, 0x1c, 0xc2, 0x16, 0xd8,
-	0x1c, 0xe0, 0x24, 0xd7, 0x17, 0x9c, 0x2a, 0xd2, 0xc0, 0x7b, 0x03, 0x9a, 0xd7, 0x0c, 0x67, 0x1c,
-	0x07, 0x22, 0xa6, 0xd9, 0x6b, 0x1a, 0x71, 0x08, 0x81, 0xa5, 0xce, 0x09, 0x9d, 0xab, 0xc6, 0xf0,
-	0x27, 0xc0, 0x4a, 0x68, 0xc4, 0xdb, 0x1b, 0x87, 0x95, 0xa3, 0xda, 0xd9, 0xde, 0xfd, 0x2b, 0xc6,
-	0x6b, 0x1a, 0x21, 0x15, 0xe2, 0xfd, 0x73, 0x03, 0x54, 0x5e, 0xd3, 0x08, 0xb6, 0xc1, 0x36, 0x0e,
-	0x43, 0x46, 0x38, 0x37, 0x4c, 0x13, 0x08, 0xf7, 0xc1, 0x96, 0xa0, 0xfd, 0x38, 0xd0, 0x74, 0x55,
-	0x64, 0x90, 0x14, 0x0e, 0xb1, 0xc0, 0xea, 0x60, 0xad, 0x23, 0x35, 0x86, 0x67, 0xa0, 0xae, 0x56,
-	0xe6, 0x67, 0x79, 0xda, 0x25, 0x4c, 0x9d, 0x8f, 0x56, 0xa7, 0x79, 0x57, 0xb8, 0x35, 0x65, 0xff,
-	0x42, 0x99, 0xd1, 0x2c, 0x80, 0x1f, 0x81, 0x6d, 0x31, 0x9c, 0x3d, 0xeb, 0x76, 0xef, 0x0a, 0xb7,
-	0x29, 0xa6, 0xcb, 0x94, 0x47, 0x19, 0xda, 0x12, 0x43, 0x75, 0xa4, 0x9d, 0x00, 0x5b, 0x0c, 0xfd,
-	0x38, 0x0b, 0xc9, 0x50, 0x1d, 0x67, 0x56, 0xa7, 0x75, 0x57, 0xb8, 0xce, 0x4c, 0xf8, 0xa5, 0xf4,
-	0xa1, 0x6d, 0x31, 0x54, 0x03, 0xf8, 0x11, 0x00, 0x7a, 0x4a, 0x4a, 0x41, 0x9f, 0x4e, 0x3b, 0x77,
-	0x85, 0x5b, 0x55, 0x56, 0xc5, 0x3d, 0x1d, 0x42, 0x0f, 0x6c, 0x6a, 0x6e, 0x5b, 0x71, 0xd7, 0xef,
-	0x0a, 0xd7, 0x4e, 0x68, 0xa4, 0x39, 0xb5, 0x4b, 0x96, 0x8a, 0x91, 0x94, 0x0e, 0x48, 0xa8, 0x8e,
-	0x08, 0x1b, 0x4d, 0xa0, 0xf7, 0xf7, 0x0d, 0x60, 0x5f, 0x0f, 0x11, 0xe1, 0x79, 0x22, 0xe0, 0xe7,
-	0xc0, 0x09, 0x68, 0x26, 0x18, 0x0e, 0x84, 0x3f, 0x57, 0xda, 0xce, 0x93, 0xe9, 0x1f, 0xfa, 0x62,
-	0x84, 0x87, 0x9a, 0x13, 0xd3, 0x0b, 0x53, 0xff, 0x16, 0xd8, 0xec, 0x26, 0x94, 0xa6, 0xaa, 0x13,
-	0xea, 0x48, 0x03, 0x88, 0x54, 0xd5, 0xd4, 0x2e, 0x57, 0xd4, 0x45, 0xf2, 0xc7, 0xf7, 0x77, 0x79,
-	0xa1, 0x55, 0x3a, 0xfb, 0xe6, 0x32, 0xd9, 0xd0, 0xda, 0x26, 0xdf, 0x93, 0xb5, 0x55, 0xad, 0xe4,
-	0x80, 0x0a, 0x23, 0x42, 0x6d, 0x5a, 0x1d, 0xc9, 0x21, 0x7c, 0x0c, 0x6c, 0x46, 0x06, 0x84, 0x09,
-	0x12, 0xaa, 0xcd, 0xb1, 0x51, 0x89, 0xe1, 0x87, 0xc0, 0x8e, 0x30, 0xf7, 0x73, 0x4e, 0x42, 0xbd,
-	0x13, 0x68, 0x3b, 0xc2, 0xfc, 0x4b, 0x4e, 0xc2, 0x4f, 0xad, 0xbf, 0x7e, 0xeb, 0xae, 0x79, 0x18,
-	0xd4, 0x5e, 0x04, 0x01, 0xe1, 0xfc, 0x3a, 0xef, 0x27, 0x64, 0x49, 0x87, 0x9d, 0x81, 0x3a, 0x17,
-	0x94, 0xe1, 0x88, 0xf8, 0x37, 0x64, 0x64, 0xfa, 0x4c, 0x77, 0x8d, 0xb1, 0xff, 0x96, 0x8c, 0x38,
-	0x9a, 0x05, 0x46, 0xe2, 0x5b, 0x0b, 0xd4, 0xae, 0x19, 0x0e, 0x88, 0xb9, 0xc0, 0xca, 0x5e, 0x95,
-	0x90, 0x19, 0x09, 0x83, 0xa4, 0xb6, 0x88, 0x53, 0x42, 0x73, 0x61, 0xbe, 0xa7, 0x09, 0x94, 0x19,
-	0x8c, 0x90, 0x21, 0x09, 0x54, 0x19, 0x2d, 0x64, 0x10, 0x3c, 0x07, 0x3b, 0x61, 0xcc, 0xd5, 0x6b,
-	0x80, 0x0b, 0x1c, 0xdc, 0xe8, 0xe5, 0x77, 0x9c, 0xbb, 0xc2, 0xad, 0x1b, 0xc7, 0x5b, 0x69, 0x47,
-	0x73, 0x08, 0x7e, 0x06, 0x9a, 0xd3, 0x34, 0x35, 0x5b, 0x7d, 0xff, 0xee, 0xc0, 0xbb, 0xc2, 0x6d,
-	0x94, 0xa1, 0xca, 0x83, 0x16, 0xb0, 0xdc, 0xe9, 0x90, 0x74, 0xf3, 0x48, 0x35, 0x9f, 0x8d, 0x34,
-	0x90, 0xd6, 0x24, 0x4e, 0x63, 0xa1, 0x9a, 0x6d, 0x13, 0x69, 0x00, 0x3f, 0x03, 0x55, 0x3a, 0x20,
-	0x8c, 0xc5, 0x21, 0xe1, 0xea, 0x02, 0xf1, 0x43, 0x4f, 0x09, 0x34, 0x8d, 0x97, 0x8b, 0x33, 0x2f,
-	0x9d, 0x94, 0xa4, 0x94, 0x8d, 0xd4, 0x15, 0xc1, 0x2c, 0x4e, 0x3b, 0x7e, 0xa7, 0xec, 0x68, 0x0e,
-	0xc1, 0x0e, 0x80, 0x26, 0x8d, 0x11, 0x91, 0xb3, 0xcc, 0x57, 0xdf, 0x7f, 0x5d, 0xe5, 0xaa, 0xaf,
-	0x50, 0x7b, 0x91, 0x72, 0xbe, 0xc2, 0x02, 0xa3, 0x7b, 0x16, 0xf8, 0x4b, 0x00, 0xf5, 0x9e, 0xf8,
-	0x5f, 0x73, 0x5a, 0xbe, 0x85, 0xf4, 0x19, 0xaf, 0xf4, 0xb5, 0xd7, 0xcc, 0xd9, 0xd1, 0xe8, 0x8a,
-	0x53, 0xb3, 0x8a, 0x2b, 0xcb, 0xb6, 0x9c, 0xcd, 0x2b, 0xcb, 0xde, 0x76, 0xec, 0xb2, 0x7e, 0x66,
-	0x15, 0x68, 0x77, 0x82, 0x67, 0xa6, 0xd7, 0xb9, 0xf8, 0xee, 0xf6, 0x60, 0xfd, 0xfb, 0xdb, 0x83,
-	0xf5, 0xff, 0xdc, 0x1e, 0xac, 0xff, 0xe3, 0xfd, 0xc1, 0xda, 0xf7, 0xef, 0x0f, 0xd6, 0xfe, 0xf5,
-	0xfe, 0x60, 0xed, 0x4f, 0x3f, 0x8d, 0x62, 0xd1, 0xcb, 0xbb, 0xc7, 0x01, 0x4d, 0x4f, 0xbe, 0x21,
-	0x02, 0x7f, 0xac, 0x9e, 0x53, 0x27, 0xd3, 0x37, 0xee, 0x50, 0xbd, 0x72, 0xc5, 0xa8, 0x4f, 0x78,
-	0x77, 0x4b, 0xbd, 0x5e, 0x9f, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x53, 0xe6, 0x09, 0x88, 0x03,
-	0x0f, 0x00, 0x00,
+	// 2125 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0xdd, 0x52, 0x23, 0xc7,
+	0xf5, 0x5f, 0x40, 0xc0, 0xa8, 0x25, 0xc4, 0xd0, 0x68, 0xb1, 0x16, 0xfe, 0xcb, 0xb0, 0xf3, 0xcf,
+	0x05, 0xa9, 0xd8, 0xb0, 0xb0, 0x21, 0xbb, 0x65, 0x57, 0x92, 0x42, 0xfb, 0x61, 0x43, 0x88, 0x43,
+	0x7a, 0x71, 0x5c, 0x4e, 0x25, 0x35, 0xd5, 0x9a, 0x69, 0x46, 0x63, 0x66, 0xa6, 0x55, 0xdd, 0x2d,
+	0xad, 0xe4, 0x27, 0x48, 0x55, 0x6e, 0x92, 0x37, 0xf0, 0xe3, 0xb8, 0x92, 0x1b, 0xdf, 0x25, 0xe5,
+	0x8b, 0xa9, 0x14, 0x7b, 0xc7, 0xa5, 0x9e, 0x20, 0xd5, 0x1f, 0x1a, 0x8d, 0x24, 0x4c, 0xb8, 0xd2,
+	0x9c, 0xdf, 0x39, 0xe7, 0x77, 0xba, 0x4f, 0x9f, 0x6e, 0x9d, 0x6e, 0xb0, 0x49, 0x44, 0x9b, 0xb0,
+	0x24, 0x4a, 0xc5, 0x3e, 0xe9, 0x25, 0xfb, 0xbd, 0x03, 0xf9, 0xb3, 0xd7, 0x61, 0x54, 0x50, 0x68,
+	0xe7, 0xba, 0x3d, 0x09, 0xf6, 0x0e, 0x36, 0xeb, 0x21, 0x0d, 0xa9, 0x52, 0xee, 0xcb, 0x2f, 0x6d,
+	0xe7, 0xfe, 0xdd, 0x02, 0x4b, 0xe7, 0x98, 0xe1, 0x84, 0xc3, 0x03, 0x50, 0x26, 0xbd, 0xc4, 0x0b,
+	0x48, 0x4a, 0x93, 0xc6, 0xdc, 0xce, 0xdc, 0x6e, 0xb9, 0x59, 0x1f, 0x66, 0x8e, 0x3d, 0xc0, 0x49,
+	0xfc, 0xb1, 0x9b, 0xab, 0x5c, 0x64, 0x91, 0x5e, 0xf2, 0x4a, 0x7e, 0xc2, 0x5f, 0x82, 0x15, 0x92,
+	0xe2, 0x56, 0x4c, 0x3c, 0x9f, 0x11, 0x2c, 0x48, 0x63, 0x7e, 0x67, 0x6e, 0xd7, 0x6a, 0x36, 0x86,
+	0x99, 0x53, 0x37, 0x6e, 0x45, 0xb5, 0x8b, 0xaa, 0x5a, 0x7e, 0xa9, 0x44, 0xf8, 0x1c, 0x54, 0x46,
+	0x7a, 0x1c, 0xc7, 0x8d, 0x05, 0xe5, 0xbc, 0x31, 0xcc, 0x1c, 0x38, 0xe9, 0x8c, 0xe3, 0xd8, 0x45,
+	0xc0, 0xb8, 0xe2, 0x38, 0x86, 0xc7, 0x00, 0x90, 0xbe, 0x60, 0xd8, 0x23, 0x51, 0x87, 0x37, 0x4a,
+	0x3b, 0x0b, 0xbb, 0x0b, 0x4d, 0xf7, 0x3a, 0x73, 0xca, 0xaf, 0x25, 0xfa, 0xfa, 0xe4, 0x9c, 0x0f,
+	0x33, 0x67, 0xcd, 0x90, 0xe4, 0x86, 0x2e, 0x2a, 0x2b, 0xe1, 0x75, 0xd4, 0xe1, 0xf0, 0xcf, 0xa0,
+	0xea, 0xb7, 0x71, 0x94, 0x7a, 0x3e, 0x4d, 0x2f, 0xa3, 0xb0, 0xb1, 0xb8, 0x33, 0xb7, 0x5b, 0x39,
+	0x7c, 0xbc, 0x37, 0x9d, 0xb7, 0xbd, 0x97, 0xd2, 0xea, 0xa5, 0x32, 0x6a, 0x6e, 0x7d, 0x97, 0x39,
+	0x0f, 0x86, 0x99, 0xb3, 0xae, 0xa9, 0x8b, 0x04, 0x2e, 0xaa, 0xf8, 0x63, 0x4b, 0x78, 0x08, 0x1e,
+	0xe2, 0x38, 0xa6, 0xef, 0xbc, 0x6e, 0x2a, 0x13, 0x4d, 0x7c, 0x41, 0x02, 0x4f, 0xf4, 0x79, 0x63,
+	0x49, 0x4e, 0x12, 0xad, 0x2b, 0xe5, 0x17, 0x63, 0xdd, 0x45, 0x9f, 0xc3, 0x33, 0x00, 0xb1, 0x2f,
+	0xa2, 0x1e, 0xf1, 0x3a, 0x8c, 0xf8, 0x34, 0xe9, 0x44, 0x31, 0xe1, 0x8d, 0xe5, 0x9d, 0x85, 0xdd,
+	0x72, 0xf3, 0xf1, 0x30, 0x73, 0x1e, 0xe9, 0xa8, 0xb3, 0x36, 0x2e, 0x5a, 0xd3, 0xe0, 0xf9, 0x18,
+	0x83, 0x6f, 0x80, 0xad, 0xb3, 0xee, 0xa9, 0x58, 0x71, 0xc4, 0x45, 0xc3, 0x52, 0x5c, 0x5b, 0xc3,
+	0xcc, 0xf9, 0xc0, 0xcc, 0x60, 0xca, 0xc2, 0x45, 0xab, 0x1a, 0x3a, 0x1e, 0x21, 0xf0, 0x04, 0xac,
+	0xb5, 0x62, 0xea, 0x5f, 0x91, 0xc0, 0xc3, 0x41, 0xc0, 0x08, 0xe7, 0x84, 0x37, 0xca, 0x8a, 0xe8,
+	0xff, 0x86, 0x99, 0xd3, 0xd0, 0x44, 0x33, 0x26, 0x2e, 0xb2, 0x0d, 0x76, 0x3c, 0x82, 0xe0, 0x05,
+	0x00, 0x97, 0x84, 0xe8, 0x32, 0xe2, 0x0d, 0xb0, 0xb3, 0xb0, 0x5b, 0x39, 0xdc, 0x9c, 0xcd, 0xf8,
+	0x1b, 0x42, 0x54, 0x79, 0x35, 0x1f, 0x99, 0x74, 0x9b, 0x95, 0x1c, 0xfb, 0xba, 0xa8, 0x7c, 0x69,
+	0x8c, 0x38, 0x6c, 0x82, 0x55, 0x15, 0xc9, 0x0b, 0x31, 0xf7, 0xe2, 0x28, 0x89, 0x44, 0xa3, 0xb2,
+	0x33, 0xb7, 0x5b, 0x6a, 0x6e, 0x0e, 0x33, 0x67, 0xa3, 0x30, 0xbc, 0xb1, 0x81, 0x8b, 0x56, 0x14,
+	0xf2, 0x29, 0xe6, 0x67, 0x52, 0x86, 0xbf, 0x07, 0x75, 0x93, 0x8a, 0x4b, 0xec, 0x0b, 0xca, 0x06,
+	0x1e, 0x0e, 0x92, 0x28, 0x6d, 0x54, 0xd5, 0x36, 0x70, 0x86, 0x99, 0xb3, 0x35, 0x91, 0xb0, 0x09,
+	0x2b, 0x17, 0x41, 0x0d, 0xbf, 0xd1, 0xe8, 0xb1, 0x04, 0xa1, 0x07, 0x1e, 0x71, 0xbf, 0x4d, 0x82,
+	0x6e, 0x4c, 0x02, 0x55, 0xc2, 0xbc, 0x30, 0xc0, 0x15, 0x35, 0xc0, 0x9f, 0x0c, 0x33, 0x67, 0x47,
+	0xf3, 0xfe, 0xa8, 0xa9, 0x8b, 0x36, 0x72, 0x9d, 0xac, 0x7d, 0x9e, 0x8f, 0xf9, 0x4b, 0xb0, 0xd1,
+	0xc2, 0xe9, 0x55, 0xa1, 0x10, 0x46, 0x99, 0xad, 0xa9, 0xd5, 0x79, 0x32, 0xcc, 0x9c, 0xc7, 0x66,
+	0xfa, 0xb7, 0xda, 0xb9, 0xa8, 0x2e, 0x15, 0xe3, 0xa2, 0xd1, 0x09, 0x75, 0xbf, 0x02, 0xd6, 0x68,
+	0x09, 0x60, 0x1d, 0x2c, 0x16, 0x0e, 0x04, 0xa4, 0x05, 0xf8, 0x1c, 0x94, 0xd8, 0x68, 0xbb, 0x97,
+	0x9b, 0xff, 0x2f, 0x97, 0xe9, 0x87, 0xcc, 0xd9, 0xf2, 0x29, 0x4f, 0x28, 0xe7, 0xc1, 0xd5, 0x5e,
+	0x44, 0xf7, 0x13, 0x2c, 0xda, 0x7b, 0x67, 0x24, 0xc4, 0xfe, 0xe0, 0x15, 0xf1, 0x91, 0x72, 0x70,
+	0xff, 0xb9, 0x06, 0x2a, 0x85, 0x0d, 0x05, 0xff, 0x04, 0x56, 0xdb, 0x34, 0x21, 0x5c, 0x10, 0x1c,
+	0x78, 0x6a, 0x49, 0xcc, 0xc9, 0xf3, 0xec, 0x87, 0xcc, 0x79, 0x38, 0xcb, 0x77, 0x92, 0x8a, 0xf1,
+	0xa2, 0x4e, 0x79, 0xba, 0xa8, 0x96, 0x23, 0x4d, 0x09, 0xc0, 0x36, 0xa8, 0x05, 0x98, 0x7a, 0x97,
+	0x94, 0x5d, 0x19, 0x72, 0x3d, 0xe0, 0xe6, 0x8f, 0x92, 0x5f, 0x67, 0x4e, 0xf5, 0xd5, 0xf1, 0xef,
+	0xde, 0x50, 0x76, 0xa5, 0x28, 0x86, 0x99, 0xf3, 0x50, 0x07, 0x9b, 0x24, 0x72, 0x51, 0x35, 0xc0,
+	0x34, 0x37, 0x83, 0x5f, 0x02, 0x3b, 0x37, 0xe0, 0xdd, 0x4e, 0x87, 0x32, 0x61, 0x8e, 0xb3, 0x8f,
+	0xae, 0x33, 0xa7, 0x66, 0x28, 0xdf, 0x6a, 0xcd, 0x78, 0xfb, 0x4d, 0xfb, 0xb8, 0xa8, 0x66, 0x68,
+	0x8d, 0x29, 0x6c, 0x81, 0x2a, 0x89, 0x3a, 0x07, 0x47, 0x4f, 0xcd, 0x04, 0x4a, 0x6a, 0x02, 0xbf,
+	0xbe, 0x6b, 0x02, 0x95, 0xd7, 0x27, 0xe7, 0x07, 0x47, 0x4f, 0x47, 0xe3, 0x37, 0x67, 0x55, 0x91,
+	0xc5, 0x45, 0x15, 0x2d, 0xea, 0xc1, 0x9f, 0x00, 0x23, 0x7a, 0x6d, 0xcc, 0xdb, 0xea, 0x24, 0x2c,
+	0x37, 0x77, 0xaf, 0x33, 0x07, 0x68, 0xa6, 0xcf, 0x30, 0x6f, 0x17, 0xb6, 0xd2, 0xe0, 0x1b, 0x9c,
+	0x8a, 0xa8, 0x9b, 0x8c, 0xb8, 0x80, 0x76, 0x96, 0x56, 0xf9, 0x70, 0x8f, 0xcc, 0x70, 0x97, 0xee,
+	0x3b, 0xdc, 0xa3, 0xdb, 0x86, 0x7b, 0x34, 0x39, 0x5c, 0x6d, 0x93, 0xc7, 0x78, 0x61, 0x62, 0x2c,
+	0xdf, 0x37, 0xc6, 0x8b, 0xdb, 0x62, 0xbc, 0x98, 0x8c, 0xa1, 0x6d, 0x64, 0x5d, 0x4e, 0xcd, 0xb3,
+	0x61, 0xdd, 0xbb, 0x2e, 0x67, 0x32, 0x54, 0xcb, 0x11, 0xcd, 0x7e, 0x05, 0xea, 0x3e, 0x4d, 0xb9,
+	0x90, 0x58, 0x4a, 0x3b, 0x31, 0x31, 0x21, 0xca, 0x2a, 0xc4, 0x8b, 0xbb, 0x42, 0x8c, 0x8e, 0xa1,
+	0x5b, 0xdc, 0x5d, 0xb4, 0x3e, 0x09, 0xeb, 0x60, 0x1e, 0xb0, 0x3b, 0x44, 0x10, 0xc6, 0x5b, 0x5d,
+	0x16, 0x9a, 0x40, 0x40, 0x05, 0xfa, 0xf9, 0x5d, 0x81, 0x4c, 0x85, 0x4e, 0xbb, 0xba, 0x68, 0x75,
+	0x0c, 0xe9, 0x00, 0x5f, 0x81, 0x5a, 0x24, 0xa3, 0xb6, 0xba, 0xb1, 0xa1, 0xaf, 0x28, 0xfa, 0xc3,
+	0xbb, 0xe8, 0xcd, 0xae, 0x9a, 0x74, 0x74, 0xd1, 0xca, 0x08, 0xd0, 0xd4, 0x01, 0x80, 0x49, 0x37,
+	0x62, 0x5e, 0x18, 0x63, 0x3f, 0x22, 0xcc, 0xd0, 0xeb, 0x43, 0xf9, 0x17, 0x77, 0xd1, 0x9b, 0xbf,
+	0xca, 0x59, 0x67, 0x17, 0xd9, 0x12, 0xfc, 0x54, 0x63, 0x3a, 0xca, 0x5b, 0x50, 0x6d, 0x11, 0x16,
+	0x47, 0xa9, 0xe1, 0x5f, 0x51, 0xfc, 0x4f, 0xef, 0xe2, 0x37, 0x15, 0x54, 0x74, 0x73, 0x51, 0x45,
+	0x8b, 0x39, 0x69, 0x4c, 0xd3, 0x80, 0x8e, 0x48, 0xd7, 0xee, 0x4d, 0x5a, 0x74, 0x73, 0x51, 0x45,
+	0x8b, 0x9a, 0x34, 0x04, 0xeb, 0x98, 0x31, 0xfa, 0x6e, 0x2a, 0x21, 0x50, 0x71, 0x3f, 0xbf, 0x8b,
+	0x7b, 0xd3, 0xf4, 0x0e, 0xb3, 0xde, 0xb2, 0x79, 0x90, 0xe8, 0x44, 0x4a, 0x02, 0x00, 0x43, 0x86,
+	0x07, 0x53, 0x71, 0xea, 0xf7, 0x4e, 0xfc, 0xac, 0xb3, 0x8b, 0x6c, 0x09, 0x4e, 0x44, 0xf9, 0x1a,
+	0xd4, 0x13, 0xc2, 0x42, 0xe2, 0xa5, 0x44, 0xf0, 0x4e, 0x1c, 0x09, 0x13, 0xe7, 0xe1, 0xbd, 0xf7,
+	0xc1, 0x6d, 0xee, 0x2e, 0x82, 0x0a, 0xfe, 0xdc, 0xa0, 0x79, 0x95, 0xf2, 0x36, 0x4e, 0xc3, 0x36,
+	0x8e, 0x4c, 0x94, 0x8d, 0x7b, 0x57, 0xe9, 0xa4, 0xa3, 0x8b, 0x56, 0x46, 0x40, 0xbe, 0xd4, 0x3e,
+	0x4e, 0xfd, 0xee, 0x68, 0xa9, 0x3f, 0xb8, 0xf7, 0x52, 0x17, 0xdd, 0x64, 0x03, 0xa9, 0x44, 0x4d,
+	0xfa, 0x07, 0x90, 0x47, 0xf1, 0x44, 0x94, 0x90, 0x46, 0x43, 0xb1, 0x1e, 0xdc, 0xc5, 0x5a, 0x9f,
+	0x1a, 0xae, 0xf4, 0x73, 0x51, 0x75, 0x24, 0x5f, 0x44, 0x09, 0x81, 0xe7, 0xc0, 0x84, 0xd1, 0xac,
+	0x8f, 0x14, 0xeb, 0xfe, 0x5d, 0xac, 0x70, 0x62, 0xac, 0x9a, 0x13, 0x68, 0x69, 0xc4, 0xd8, 0x61,
+	0x38, 0xec, 0x12, 0xcd, 0xb8, 0x79, 0x6f, 0xc6, 0x82, 0x97, 0x8b, 0x80, 0x96, 0x46, 0x8c, 0x3d,
+	0xc2, 0xae, 0x62, 0xc3, 0xb8, 0x75, 0x6f, 0xc6, 0x82, 0x97, 0x8b, 0x80, 0x96, 0x24, 0xe3, 0x69,
+	0xc9, 0xaa, 0xd9, 0xab, 0xa7, 0x25, 0x6b, 0xd5, 0xb6, 0x4f, 0x4b, 0x96, 0x6d, 0xaf, 0x9d, 0x96,
+	0xac, 0x75, 0xbb, 0x8e, 0x56, 0x06, 0x34, 0xa6, 0x5e, 0xef, 0x99, 0x5e, 0x02, 0x54, 0x21, 0xef,
+	0x30, 0x37, 0xc7, 0x36, 0xaa, 0xf9, 0x58, 0xe0, 0x78, 0xc0, 0x4d, 0x59, 0x21, 0x5b, 0x17, 0x5b,
+	0xa1, 0x09, 0xd8, 0x07, 0x8b, 0x6f, 0x85, 0xbc, 0xc8, 0xd8, 0x60, 0xe1, 0x8a, 0x0c, 0x4c, 0x8f,
+	0x24, 0x3f, 0x65, 0xdf, 0xd4, 0xc3, 0x71, 0xd7, 0xb4, 0x48, 0x48, 0x0b, 0xee, 0x39, 0x58, 0xbd,
+	0x60, 0x38, 0xe5, 0xb2, 0x5b, 0xa7, 0xe9, 0x19, 0x0d, 0x39, 0x84, 0xa0, 0xa4, 0xfe, 0x75, 0xb5,
+	0xaf, 0xfa, 0x86, 0x3f, 0x05, 0xa5, 0x98, 0x86, 0xbc, 0x31, 0xaf, 0x3a, 0xe4, 0x87, 0xb3, 0x1d,
+	0xf2, 0x19, 0x0d, 0x91, 0x32, 0x71, 0xff, 0x31, 0x0f, 0x16, 0xce, 0x68, 0x08, 0x1b, 0x60, 0xd9,
+	0xb4, 0xde, 0x86, 0x69, 0x24, 0xc2, 0x0d, 0xb0, 0x24, 0x68, 0x27, 0xf2, 0x35, 0x5d, 0x19, 0x19,
+	0x49, 0x06, 0x0e, 0xb0, 0xc0, 0xaa, 0x4d, 0xa9, 0x22, 0xf5, 0x0d, 0x0f, 0x41, 0x55, 0x77, 0xca,
+	0x69, 0x37, 0x69, 0x11, 0xa6, 0xba, 0x8d, 0x52, 0x73, 0xf5, 0x26, 0x73, 0x2a, 0x0a, 0xff, 0x5c,
+	0xc1, 0xa8, 0x28, 0xc0, 0x0f, 0xc1, 0xb2, 0xe8, 0x17, 0x3b, 0x87, 0xf5, 0x9b, 0xcc, 0x59, 0x15,
+	0xe3, 0x69, 0xca, 0xc6, 0x00, 0x2d, 0x89, 0xbe, 0x6a, 0x10, 0xf6, 0x81, 0x25, 0xfa, 0x5e, 0x94,
+	0x06, 0xa4, 0xaf, 0x9a, 0x83, 0x52, 0xb3, 0x7e, 0x93, 0x39, 0x76, 0xc1, 0xfc, 0x44, 0xea, 0xd0,
+	0xb2, 0xe8, 0xab, 0x0f, 0xf8, 0x21, 0x00, 0x7a, 0x48, 0x2a, 0x82, 0xfe, 0xaf, 0x5f, 0xb9, 0xc9,
+	0x9c, 0xb2, 0x42, 0x15, 0xf7, 0xf8, 0x13, 0xba, 0x60, 0x51, 0x73, 0x5b, 0x8a, 0xbb, 0x7a, 0x93,
+	0x39, 0x56, 0x4c, 0x43, 0xcd, 0xa9, 0x55, 0x32, 0x55, 0x8c, 0x24, 0xb4, 0x47, 0x02, 0xf5, 0x87,
+	0x6b, 0xa1, 0x91, 0xe8, 0xfe, 0x75, 0x1e, 0x58, 0x17, 0x7d, 0x44, 0x78, 0x37, 0x16, 0xea, 0xfe,
+	0x44, 0x53, 0xc1, 0xb0, 0x2f, 0xbc, 0x89, 0xd4, 0x4e, 0xdc, 0x9f, 0xa6, 0x2c, 0xe4, 0xfd, 0xc9,
+	0x40, 0xe6, 0xda, 0x23, 0x2b, 0xa1, 0x15, 0x53, 0x9a, 0xa8, 0x4a, 0xa8, 0x22, 0x2d, 0x40, 0xa4,
+	0xb2, 0xa6, 0x56, 0x79, 0x41, 0xdd, 0x3c, 0x9f, 0xcc, 0xae, 0xf2, 0x54, 0xa9, 0x34, 0x37, 0xcc,
+	0x75, 0xa8, 0xa6, 0x63, 0x1b, 0x7f, 0x57, 0xe6, 0x56, 0x95, 0x92, 0x0d, 0x16, 0x18, 0x11, 0x6a,
+	0xd1, 0xaa, 0x48, 0x7e, 0xc2, 0x4d, 0x60, 0x31, 0xd2, 0x23, 0x4c, 0x90, 0x40, 0x2d, 0x8e, 0x85,
+	0x72, 0x19, 0x3e, 0x02, 0x96, 0xbc, 0x64, 0x74, 0x39, 0x09, 0xf4, 0x4a, 0xa0, 0xe5, 0x10, 0xf3,
+	0x2f, 0x38, 0x09, 0x3e, 0x2e, 0xfd, 0xe5, 0x5b, 0xe7, 0x81, 0x8b, 0x41, 0xe5, 0xd8, 0xf7, 0x09,
+	0xe7, 0x17, 0xdd, 0x4e, 0x4c, 0xee, 0xa8, 0xb0, 0x43, 0x50, 0xe5, 0x82, 0x32, 0x1c, 0x12, 0xef,
+	0x8a, 0x0c, 0x4c, 0x9d, 0xe9, 0xaa, 0x31, 0xf8, 0x6f, 0xc8, 0x80, 0xa3, 0xa2, 0x60, 0x42, 0x7c,
+	0x5b, 0x02, 0x95, 0x0b, 0x86, 0x7d, 0x62, 0xae, 0x03, 0xb2, 0x56, 0xa5, 0xc8, 0x4c, 0x08, 0x23,
+	0xc9, 0xd8, 0x72, 0x4f, 0xd3, 0xae, 0x30, 0xfb, 0x69, 0x24, 0x4a, 0x0f, 0x46, 0x48, 0x9f, 0xf8,
+	0x2a, 0x8d, 0x25, 0x64, 0x24, 0x78, 0x04, 0x56, 0x82, 0x88, 0xab, 0xe7, 0x03, 0x2e, 0xb0, 0x7f,
+	0xa5, 0xa7, 0xdf, 0xb4, 0x6f, 0x32, 0xa7, 0x6a, 0x14, 0x6f, 0x25, 0x8e, 0x26, 0x24, 0xf8, 0x09,
+	0x58, 0x1d, 0xbb, 0xa9, 0xd1, 0xea, 0x0b, 0x7b, 0x13, 0xde, 0x64, 0x4e, 0x2d, 0x37, 0x55, 0x1a,
+	0x34, 0x25, 0xeb, 0xbb, 0x52, 0xab, 0x1b, 0xaa, 0xe2, 0xb3, 0x90, 0x16, 0x24, 0xaa, 0xef, 0x7c,
+	0xb2, 0xd8, 0x16, 0x91, 0x16, 0xe0, 0x27, 0xa0, 0x4c, 0x7b, 0x84, 0xb1, 0x28, 0x20, 0x5c, 0xb5,
+	0x63, 0xff, 0xeb, 0xed, 0x01, 0x8d, 0xed, 0xe5, 0xe4, 0xcc, 0xd3, 0x48, 0x42, 0x12, 0xca, 0x06,
+	0xaa, 0xe1, 0x32, 0x93, 0xd3, 0x8a, 0xdf, 0x2a, 0x1c, 0x4d, 0x48, 0xb0, 0x09, 0xa0, 0x71, 0x63,
+	0x44, 0x74, 0x59, 0xea, 0xa9, 0xfd, 0x5f, 0x55, 0xbe, 0x6a, 0x17, 0x6a, 0x2d, 0x52, 0xca, 0x57,
+	0x58, 0x60, 0x34, 0x83, 0xc0, 0x5f, 0x01, 0xa8, 0xd7, 0xc4, 0xfb, 0x9a, 0xd3, 0xfc, 0xf1, 0x44,
+	0x77, 0x4c, 0x2a, 0xbe, 0xd6, 0x9a, 0x31, 0xdb, 0x5a, 0x3a, 0xe5, 0xd4, 0xcc, 0xe2, 0xb4, 0x64,
+	0x95, 0xec, 0xc5, 0xd3, 0x92, 0xb5, 0x6c, 0x5b, 0x79, 0xfe, 0xcc, 0x2c, 0xd0, 0xfa, 0x48, 0x2e,
+	0x0c, 0xcf, 0xfd, 0xd7, 0x3c, 0x58, 0x79, 0x5b, 0xbc, 0x00, 0xc3, 0x1a, 0x98, 0x8f, 0x02, 0x55,
+	0x20, 0x25, 0x34, 0x1f, 0x05, 0xb2, 0xc8, 0x47, 0x7b, 0xce, 0x54, 0x47, 0x2e, 0xdf, 0x7a, 0xc8,
+	0x6d, 0x81, 0xf2, 0xf8, 0x22, 0xae, 0x4e, 0x38, 0x24, 0x77, 0x82, 0xbe, 0x54, 0xd7, 0xc1, 0x62,
+	0x07, 0x0f, 0x08, 0xd3, 0x67, 0x19, 0xd2, 0x82, 0x0c, 0x11, 0xa5, 0x82, 0xb0, 0x1e, 0x8e, 0xcd,
+	0x5e, 0xc9, 0x65, 0xe8, 0x80, 0x4a, 0x4a, 0xfa, 0xc2, 0x6b, 0x93, 0x28, 0x6c, 0x0b, 0x75, 0x42,
+	0x95, 0x10, 0x90, 0xd0, 0x67, 0x0a, 0x81, 0x4f, 0x40, 0x35, 0xc1, 0x7d, 0xef, 0x12, 0x47, 0x71,
+	0x97, 0x11, 0xae, 0x8f, 0x26, 0x54, 0x49, 0x70, 0xff, 0x8d, 0x81, 0x24, 0x7f, 0xae, 0x2e, 0x6b,
+	0xfe, 0xcb, 0x82, 0xce, 0xe4, 0x25, 0x50, 0x85, 0x62, 0xa1, 0x5c, 0x96, 0x55, 0xa4, 0x9e, 0x6e,
+	0x70, 0xea, 0x13, 0xd3, 0x75, 0x3f, 0x36, 0x97, 0xf1, 0xdb, 0xff, 0x2a, 0xd1, 0xd8, 0xbe, 0xf9,
+	0xfa, 0xbb, 0xeb, 0xed, 0xb9, 0xef, 0xaf, 0xb7, 0xe7, 0xfe, 0x73, 0xbd, 0x3d, 0xf7, 0xb7, 0xf7,
+	0xdb, 0x0f, 0xbe, 0x7f, 0xbf, 0xfd, 0xe0, 0xdf, 0xef, 0xb7, 0x1f, 0xfc, 0xf1, 0x67, 0x61, 0x24,
+	0xda, 0xdd, 0xd6, 0x9e, 0x4f, 0x93, 0xfd, 0x6f, 0x88, 0xc0, 0x1f, 0xa9, 0x97, 0xad, 0xfd, 0xf1,
+	0x73, 0x63, 0x5f, 0x3d, 0x38, 0x8a, 0x41, 0x87, 0xf0, 0xd6, 0x92, 0x7a, 0x48, 0x7c, 0xf6, 0xdf,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0xda, 0xae, 0x2c, 0x60, 0x8e, 0x14, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -813,6 +1123,73 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BankPrecompileDenoms) > 0 {
+		for iNdEx := len(m.BankPrecompileDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BankPrecompileDenoms[iNdEx])
+			copy(dAtA[i:], m.BankPrecompileDenoms[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.BankPrecompileDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.ScheduledCallsGasLimit != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ScheduledCallsGasLimit))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.CreateFactoryAdmin) > 0 {
+		i -= len(m.CreateFactoryAdmin)
+		copy(dAtA[i:], m.CreateFactoryAdmin)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.CreateFactoryAdmin)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.BlockGasLimit != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BlockGasLimit))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BlockedAddresses) > 0 {
+		for iNdEx := len(m.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedAddresses[iNdEx])
+			copy(dAtA[i:], m.BlockedAddresses[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.BlockedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.CreateAllowlist) > 0 {
+		for iNdEx := len(m.CreateAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CreateAllowlist[iNdEx])
+			copy(dAtA[i:], m.CreateAllowlist[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.CreateAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ActivePrecompiles) > 0 {
+		for iNdEx := len(m.ActivePrecompiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActivePrecompiles[iNdEx])
+			copy(dAtA[i:], m.ActivePrecompiles[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.ActivePrecompiles[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.AllowUnprotectedTxs {
 		i--
 		if m.AllowUnprotectedTxs {
@@ -882,6 +1259,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -902,6 +1319,62 @@ func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VerkleTime != nil {
+		{
+			size := m.VerkleTime.Size()
+			i -= size
+			if _, err := m.VerkleTime.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.PragueTime != nil {
+		{
+			size := m.PragueTime.Size()
+			i -= size
+			if _, err := m.PragueTime.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.CancunTime != nil {
+		{
+			size := m.CancunTime.Size()
+			i -= size
+			if _, err := m.CancunTime.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.ShanghaiTime != nil {
+		{
+			size := m.ShanghaiTime.Size()
+			i -= size
+			if _, err := m.ShanghaiTime.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
 	if m.CancunBlock != nil {
 		{
 			size := m.CancunBlock.Size()
@@ -1528,9 +2001,103 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvm(v)
-	base := offset
+func (m *ScheduledCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Failures != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxFailures != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxFailures))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Interval != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvm(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
@@ -1567,6 +2134,61 @@ func (m *Params) Size() (n int) {
 	if m.AllowUnprotectedTxs {
 		n += 2
 	}
+	if len(m.ActivePrecompiles) > 0 {
+		for _, s := range m.ActivePrecompiles {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.CreateAllowlist) > 0 {
+		for _, s := range m.CreateAllowlist {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.BlockedAddresses) > 0 {
+		for _, s := range m.BlockedAddresses {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.BlockGasLimit != 0 {
+		n += 1 + sovEvm(uint64(m.BlockGasLimit))
+	}
+	l = len(m.CreateFactoryAdmin)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.ScheduledCallsGasLimit != 0 {
+		n += 1 + sovEvm(uint64(m.ScheduledCallsGasLimit))
+	}
+	if len(m.BankPrecompileDenoms) > 0 {
+		for _, s := range m.BankPrecompileDenoms {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovEvm(uint64(l))
 	return n
 }
 
@@ -1651,6 +2273,22 @@ func (m *ChainConfig) Size() (n int) {
 		l = m.CancunBlock.Size()
 		n += 2 + l + sovEvm(uint64(l))
 	}
+	if m.ShanghaiTime != nil {
+		l = m.ShanghaiTime.Size()
+		n += 2 + l + sovEvm(uint64(l))
+	}
+	if m.CancunTime != nil {
+		l = m.CancunTime.Size()
+		n += 2 + l + sovEvm(uint64(l))
+	}
+	if m.PragueTime != nil {
+		l = m.PragueTime.Size()
+		n += 2 + l + sovEvm(uint64(l))
+	}
+	if m.VerkleTime != nil {
+		l = m.VerkleTime.Size()
+		n += 2 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
@@ -1827,6 +2465,50 @@ func (m *TraceConfig) Size() (n int) {
 	return n
 }
 
+func (m *ScheduledCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvm(uint64(m.Id))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovEvm(uint64(m.GasLimit))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovEvm(uint64(m.Interval))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovEvm(uint64(m.NextHeight))
+	}
+	if m.MaxFailures != 0 {
+		n += 1 + sovEvm(uint64(m.MaxFailures))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovEvm(uint64(m.Failures))
+	}
+	if m.Disabled {
+		n += 2
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
 func sovEvm(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2063,59 +2745,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUnprotectedTxs = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvm(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChainConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvm
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePrecompiles", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2143,15 +2775,11 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.HomesteadBlock = &v
-			if err := m.HomesteadBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ActivePrecompiles = append(m.ActivePrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DAOForkBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAllowlist", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2179,35 +2807,11 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.DAOForkBlock = &v
-			if err := m.DAOForkBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CreateAllowlist = append(m.CreateAllowlist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DAOForkSupport", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DAOForkSupport = bool(v != 0)
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EIP150Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2235,17 +2839,13 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.EIP150Block = &v
-			if err := m.EIP150Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BlockedAddresses = append(m.BlockedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EIP150Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2255,29 +2855,31 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EIP150Hash = string(dAtA[iNdEx:postIndex])
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EIP155Block", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasLimit", wireType)
 			}
-			var stringLen uint64
+			m.BlockGasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2287,13 +2889,32 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockGasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateFactoryAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvm
 			}
 			postIndex := iNdEx + intStringLen
@@ -2303,15 +2924,30 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.EIP155Block = &v
-			if err := m.EIP155Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CreateFactoryAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCallsGasLimit", wireType)
+			}
+			m.ScheduledCallsGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledCallsGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EIP158Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BankPrecompileDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2339,15 +2975,61 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.EIP158Block = &v
-			if err := m.EIP158Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BankPrecompileDenoms = append(m.BankPrecompileDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ByzantiumBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2375,15 +3057,11 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.ByzantiumBlock = &v
-			if err := m.ByzantiumBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConstantinopleBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2411,15 +3089,63 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.ConstantinopleBlock = &v
-			if err := m.ConstantinopleBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PetersburgBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2448,14 +3174,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.PetersburgBlock = &v
-			if err := m.PetersburgBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HomesteadBlock = &v
+			if err := m.HomesteadBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IstanbulBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DAOForkBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2484,14 +3210,34 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.IstanbulBlock = &v
-			if err := m.IstanbulBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DAOForkBlock = &v
+			if err := m.DAOForkBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DAOForkSupport", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DAOForkSupport = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MuirGlacierBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP150Block", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2520,14 +3266,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.MuirGlacierBlock = &v
-			if err := m.MuirGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EIP150Block = &v
+			if err := m.EIP150Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BerlinBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP150Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2555,15 +3301,11 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.BerlinBlock = &v
-			if err := m.BerlinBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EIP150Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LondonBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP155Block", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2592,14 +3334,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.LondonBlock = &v
-			if err := m.LondonBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EIP155Block = &v
+			if err := m.EIP155Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArrowGlacierBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP158Block", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2628,14 +3370,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.ArrowGlacierBlock = &v
-			if err := m.ArrowGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EIP158Block = &v
+			if err := m.EIP158Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GrayGlacierBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ByzantiumBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2664,14 +3406,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.GrayGlacierBlock = &v
-			if err := m.GrayGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ByzantiumBlock = &v
+			if err := m.ByzantiumBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeNetsplitBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstantinopleBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2700,14 +3442,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.MergeNetsplitBlock = &v
-			if err := m.MergeNetsplitBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConstantinopleBlock = &v
+			if err := m.ConstantinopleBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShanghaiBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PetersburgBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2736,14 +3478,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.ShanghaiBlock = &v
-			if err := m.ShanghaiBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PetersburgBlock = &v
+			if err := m.PetersburgBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancunBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IstanbulBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2772,11 +3514,673 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.CancunBlock = &v
+			m.IstanbulBlock = &v
+			if err := m.IstanbulBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MuirGlacierBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MuirGlacierBlock = &v
+			if err := m.MuirGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BerlinBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.BerlinBlock = &v
+			if err := m.BerlinBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LondonBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.LondonBlock = &v
+			if err := m.LondonBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrowGlacierBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.ArrowGlacierBlock = &v
+			if err := m.ArrowGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrayGlacierBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.GrayGlacierBlock = &v
+			if err := m.GrayGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeNetsplitBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MergeNetsplitBlock = &v
+			if err := m.MergeNetsplitBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShanghaiBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.ShanghaiBlock = &v
+			if err := m.ShanghaiBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancunBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.CancunBlock = &v
 			if err := m.CancunBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShanghaiTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.ShanghaiTime = &v
+			if err := m.ShanghaiTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancunTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.CancunTime = &v
+			if err := m.CancunTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PragueTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.PragueTime = &v
+			if err := m.PragueTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerkleTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.VerkleTime = &v
+			if err := m.VerkleTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *State) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: State: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionLogs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionLogs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -2798,7 +4202,7 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *State) Unmarshal(dAtA []byte) error {
+func (m *Log) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2821,15 +4225,15 @@ func (m *State) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: State: wiretype end group for non-group")
+			return fmt.Errorf("proto: Log: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2857,11 +4261,11 @@ func (m *State) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2889,61 +4293,64 @@ func (m *State) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvm(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvm
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionLogs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionLogs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2971,13 +4378,32 @@ func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2987,26 +4413,63 @@ func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, &Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Removed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -3028,7 +4491,7 @@ func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Log) Unmarshal(dAtA []byte) error {
+func (m *TxResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3051,15 +4514,15 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Log: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3087,13 +4550,13 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bloom", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3103,27 +4566,62 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bloom = append(m.Bloom[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bloom == nil {
+				m.Bloom = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxLogs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
+			if err := m.TxLogs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3150,16 +4648,16 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverted", wireType)
 			}
-			m.BlockNumber = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3169,16 +4667,17 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockNumber |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			m.Reverted = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			var stringLen uint64
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3188,46 +4687,64 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvm
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessTuple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
 			}
-			m.TxIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessTuple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3255,13 +4772,13 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageKeys", wireType)
 			}
-			m.Index = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3271,31 +4788,24 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
 			}
-			m.Removed = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageKeys = append(m.StorageKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -3317,7 +4827,7 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxResult) Unmarshal(dAtA []byte) error {
+func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3340,15 +4850,15 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: TraceConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TraceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tracer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3376,13 +4886,13 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Tracer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bloom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3392,31 +4902,29 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bloom = append(m.Bloom[:0], dAtA[iNdEx:postIndex]...)
-			if m.Bloom == nil {
-				m.Bloom = []byte{}
-			}
+			m.Timeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxLogs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reexec", wireType)
 			}
-			var msglen int
+			m.Reexec = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3426,30 +4934,95 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Reexec |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvm
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableStack", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DisableStack = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableStorage", wireType)
 			}
-			if err := m.TxLogs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableStorage = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Debug = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3459,29 +5032,31 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
-			if m.Ret == nil {
-				m.Ret = []byte{}
+			if m.Overrides == nil {
+				m.Overrides = &ChainConfig{}
+			}
+			if err := m.Overrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableMemory", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -3498,81 +5073,12 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Reverted = bool(v != 0)
-		case 6:
+			m.EnableMemory = bool(v != 0)
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
-			}
-			m.GasUsed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasUsed |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvm(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AccessTuple) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvm
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AccessTuple: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessTuple: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableReturnData", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3582,27 +5088,15 @@ func (m *AccessTuple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.EnableReturnData = bool(v != 0)
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TracerJsonConfig", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3630,7 +5124,7 @@ func (m *AccessTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageKeys = append(m.StorageKeys, string(dAtA[iNdEx:postIndex]))
+			m.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3653,7 +5147,7 @@ func (m *AccessTuple) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TraceConfig) Unmarshal(dAtA []byte) error {
+func (m *ScheduledCall) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3676,17 +5170,17 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TraceConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledCall: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TraceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledCall: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tracer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3696,27 +5190,14 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tracer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3744,13 +5225,13 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timeout = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reexec", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.Reexec = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3760,16 +5241,31 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Reexec |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if byteLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableStack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			var v int
+			m.GasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3779,17 +5275,16 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DisableStack = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableStorage", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3799,17 +5294,29 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DisableStorage = bool(v != 0)
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var v int
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3819,17 +5326,16 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Interval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Debug = bool(v != 0)
-		case 9:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
 			}
-			m.Limit = 0
+			m.NextHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3839,16 +5345,16 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
+				m.NextHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailures", wireType)
 			}
-			var msglen int
+			m.MaxFailures = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3858,33 +5364,16 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxFailures |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Overrides == nil {
-				m.Overrides = &ChainConfig{}
-			}
-			if err := m.Overrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableMemory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
 			}
-			var v int
+			m.Failures = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3894,15 +5383,14 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Failures |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableMemory = bool(v != 0)
-		case 12:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableReturnData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -3919,10 +5407,10 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.EnableReturnData = bool(v != 0)
-		case 13:
+			m.Disabled = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TracerJsonConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3950,7 +5438,9 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -60,5 +60,20 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	seenCalls := make(map[uint64]bool)
+	for _, call := range gs.ScheduledCalls {
+		if seenCalls[call.Id] {
+			return fmt.Errorf("duplicated scheduled call %d", call.Id)
+		}
+		if err := call.Validate(); err != nil {
+			return fmt.Errorf("invalid scheduled call %d: %w", call.Id, err)
+		}
+		seenCalls[call.Id] = true
+	}
+
+	if err := validateAddresses("create factory", gs.CreateFactories); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
@@ -29,6 +29,11 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// scheduled_calls defines the contract calls executed at the end of the blocks.
+	ScheduledCalls []ScheduledCall `protobuf:"bytes,3,rep,name=scheduled_calls,json=scheduledCalls,proto3" json:"scheduled_calls"`
+	// create_factories defines the hex addresses of the factory contracts allowed to
+	// deploy contracts with CREATE/CREATE2.
+	CreateFactories []string `protobuf:"bytes,4,rep,name=create_factories,json=createFactories,proto3" json:"create_factories,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +83,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetScheduledCalls() []ScheduledCall {
+	if m != nil {
+		return m.ScheduledCalls
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCreateFactories() []string {
+	if m != nil {
+		return m.CreateFactories
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -152,26 +171,31 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/genesis.proto", fileDescriptor_9bcdec50cc9d156d) }
 
 var fileDescriptor_9bcdec50cc9d156d = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0xbf, 0x4a, 0xc3, 0x40,
-	0x1c, 0xce, 0xd9, 0xd2, 0xda, 0xab, 0xa8, 0x1c, 0x82, 0xa1, 0xc3, 0xb5, 0x74, 0x2a, 0x88, 0x77,
-	0xb4, 0x82, 0xbb, 0x01, 0x71, 0x95, 0x74, 0x73, 0xbb, 0x26, 0x3f, 0x92, 0x0c, 0xc9, 0x85, 0xdc,
-	0x35, 0xa8, 0xa3, 0xa3, 0x93, 0xcf, 0xe1, 0x93, 0x74, 0xec, 0xe8, 0xa4, 0x92, 0xbc, 0x88, 0xe4,
-	0x92, 0x56, 0x34, 0xdb, 0x97, 0x7c, 0xff, 0x7e, 0xf7, 0x61, 0x0a, 0x3a, 0x84, 0x2c, 0x8e, 0x12,
-	0xcd, 0x21, 0x8f, 0x79, 0x3e, 0xe7, 0x01, 0x24, 0xa0, 0x22, 0xc5, 0xd2, 0x4c, 0x6a, 0x49, 0x4e,
-	0xf7, 0x3c, 0x83, 0x3c, 0x66, 0xf9, 0x7c, 0x34, 0x6a, 0x39, 0x2a, 0xc2, 0xa8, 0x47, 0x67, 0x81,
-	0x0c, 0xa4, 0x81, 0xbc, 0x42, 0xf5, 0xdf, 0xe9, 0x2b, 0xc2, 0x47, 0x77, 0x75, 0xea, 0x52, 0x0b,
-	0x0d, 0xc4, 0xc1, 0x87, 0xc2, 0xf3, 0xe4, 0x3a, 0xd1, 0xca, 0x46, 0x93, 0xce, 0x6c, 0xb8, 0x98,
-	0xb0, 0xff, 0x3d, 0xac, 0x71, 0xdc, 0xd4, 0x42, 0xa7, 0xbb, 0xf9, 0x1c, 0x5b, 0xee, 0xde, 0x47,
-	0xae, 0x71, 0x2f, 0x15, 0x99, 0x88, 0x95, 0x7d, 0x30, 0x41, 0xb3, 0xe1, 0xc2, 0x6e, 0x27, 0xdc,
-	0x1b, 0xbe, 0x71, 0x36, 0xea, 0xe9, 0x0b, 0xc2, 0xc7, 0x7f, 0xa3, 0x89, 0x8d, 0xfb, 0xc2, 0xf7,
-	0x33, 0x50, 0xd5, 0x35, 0x68, 0x36, 0x70, 0x77, 0x9f, 0x84, 0xe0, 0xae, 0x27, 0x7d, 0x30, 0x15,
-	0x03, 0xd7, 0x60, 0xe2, 0xe0, 0xbe, 0xd2, 0x32, 0x13, 0x01, 0xd8, 0x1d, 0x73, 0xfb, 0x79, 0xbb,
-	0xd9, 0x3c, 0xd3, 0x39, 0xa9, 0x8a, 0xdf, 0xbf, 0xc6, 0xfd, 0x65, 0xad, 0x77, 0x77, 0x46, 0xe7,
-	0x76, 0x53, 0x50, 0xb4, 0x2d, 0x28, 0xfa, 0x2e, 0x28, 0x7a, 0x2b, 0xa9, 0xb5, 0x2d, 0xa9, 0xf5,
-	0x51, 0x52, 0xeb, 0xe1, 0x22, 0x88, 0x74, 0xb8, 0x5e, 0x31, 0x4f, 0xc6, 0xfc, 0x19, 0xb4, 0xb8,
-	0xf4, 0x42, 0x11, 0x25, 0xfc, 0x77, 0xf3, 0x47, 0xb3, 0xba, 0x7e, 0x4a, 0x41, 0xad, 0x7a, 0x66,
-	0xdf, 0xab, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x28, 0x29, 0xa9, 0xc5, 0x01, 0x00, 0x00,
+	// 370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0x4d, 0x4f, 0xea, 0x40,
+	0x14, 0x6d, 0x81, 0xc0, 0x63, 0x78, 0x01, 0x32, 0x79, 0xc9, 0x6b, 0x58, 0x94, 0x86, 0x55, 0x5f,
+	0x5e, 0x6c, 0x03, 0x26, 0xee, 0xad, 0x51, 0x77, 0xc6, 0x94, 0x9d, 0x1b, 0x32, 0x4c, 0xaf, 0x6d,
+	0x93, 0xb6, 0x43, 0x3a, 0x43, 0xa3, 0x2e, 0x4d, 0xdc, 0xfb, 0x3b, 0xfc, 0x25, 0x2c, 0x59, 0xba,
+	0x52, 0x03, 0x7f, 0xc4, 0x74, 0xda, 0xe2, 0x07, 0xee, 0xee, 0x9c, 0x7b, 0xce, 0x99, 0x73, 0xef,
+	0x45, 0x3a, 0x88, 0x00, 0xd2, 0x38, 0x4c, 0x84, 0x0d, 0x59, 0x6c, 0x67, 0x63, 0xdb, 0x87, 0x04,
+	0x78, 0xc8, 0xad, 0x45, 0xca, 0x04, 0xc3, 0xfd, 0x5d, 0xdf, 0x82, 0x2c, 0xb6, 0xb2, 0xf1, 0x60,
+	0xb0, 0xa7, 0xc8, 0x1b, 0x92, 0x3d, 0xf8, 0xe3, 0x33, 0x9f, 0xc9, 0xd2, 0xce, 0xab, 0x02, 0x1d,
+	0x3d, 0xd4, 0xd0, 0xef, 0xf3, 0xc2, 0x75, 0x2a, 0x88, 0x00, 0xec, 0xa0, 0x5f, 0x84, 0x52, 0xb6,
+	0x4c, 0x04, 0xd7, 0x54, 0xa3, 0x6e, 0x76, 0x26, 0x86, 0xf5, 0xfd, 0x1f, 0xab, 0x54, 0x1c, 0x17,
+	0x44, 0xa7, 0xb1, 0x7a, 0x19, 0x2a, 0xee, 0x4e, 0x87, 0x8f, 0x50, 0x73, 0x41, 0x52, 0x12, 0x73,
+	0xad, 0x66, 0xa8, 0x66, 0x67, 0xa2, 0xed, 0x3b, 0x5c, 0xca, 0x7e, 0xa9, 0x2c, 0xd9, 0xf8, 0x02,
+	0xf5, 0x38, 0x0d, 0xc0, 0x5b, 0x46, 0xe0, 0xcd, 0x28, 0x89, 0x22, 0xae, 0xd5, 0x65, 0x84, 0xe1,
+	0xbe, 0xc1, 0xb4, 0x22, 0x9e, 0x90, 0x28, 0x2a, 0x7d, 0xba, 0xfc, 0x33, 0xc8, 0xf1, 0x3f, 0xd4,
+	0xa7, 0x29, 0x10, 0x01, 0xb3, 0x6b, 0x42, 0x05, 0x4b, 0x43, 0xe0, 0x5a, 0xc3, 0xa8, 0x9b, 0x6d,
+	0xb7, 0x57, 0xe0, 0x67, 0x15, 0x3c, 0xba, 0x57, 0x51, 0xf7, 0xeb, 0x54, 0x58, 0x43, 0x2d, 0xe2,
+	0x79, 0x29, 0xf0, 0x7c, 0x11, 0xaa, 0xd9, 0x76, 0xab, 0x27, 0xc6, 0xa8, 0x41, 0x99, 0x07, 0x72,
+	0xba, 0xb6, 0x2b, 0x6b, 0xec, 0xa0, 0x16, 0x17, 0x2c, 0x25, 0x3e, 0x94, 0x99, 0xff, 0xfe, 0x90,
+	0x39, 0xdf, 0xb0, 0xd3, 0xcb, 0xb3, 0x3e, 0xbd, 0x0e, 0x5b, 0xd3, 0x82, 0xef, 0x56, 0x42, 0xe7,
+	0x74, 0xb5, 0xd1, 0xd5, 0xf5, 0x46, 0x57, 0xdf, 0x36, 0xba, 0xfa, 0xb8, 0xd5, 0x95, 0xf5, 0x56,
+	0x57, 0x9e, 0xb7, 0xba, 0x72, 0xf5, 0xdf, 0x0f, 0x45, 0xb0, 0x9c, 0x5b, 0x94, 0xc5, 0xf6, 0x1d,
+	0x08, 0x72, 0x40, 0x03, 0x12, 0x26, 0xf6, 0xc7, 0xb9, 0x6f, 0xe4, 0xc1, 0xc5, 0xed, 0x02, 0xf8,
+	0xbc, 0x29, 0x4f, 0x7b, 0xf8, 0x1e, 0x00, 0x00, 0xff, 0xff, 0x74, 0x98, 0xde, 0xeb, 0x40, 0x02,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -194,6 +218,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CreateFactories) > 0 {
+		for iNdEx := len(m.CreateFactories) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CreateFactories[iNdEx])
+			copy(dAtA[i:], m.CreateFactories[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CreateFactories[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ScheduledCalls) > 0 {
+		for iNdEx := len(m.ScheduledCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -297,6 +344,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ScheduledCalls) > 0 {
+		for _, e := range m.ScheduledCalls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CreateFactories) > 0 {
+		for _, s := range m.CreateFactories {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -425,6 +484,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledCalls = append(m.ScheduledCalls, ScheduledCall{})
+			if err := m.ScheduledCalls[len(m.ScheduledCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateFactories", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateFactories = append(m.CreateFactories, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
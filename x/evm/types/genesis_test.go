@@ -3,6 +3,9 @@ package types
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
@@ -83,6 +86,8 @@ func (suite *GenesisTestSuite) TestValidateGenesisAccount() {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
+	payer := sdk.AccAddress(common.HexToAddress(suite.address).Bytes()).String()
+
 	testCases := []struct {
 		name     string
 		genState *GenesisState
@@ -211,6 +216,64 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid scheduled calls",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ScheduledCalls: []ScheduledCall{
+					{Id: 1, Contract: suite.address, Payer: payer, GasLimit: 100000, Interval: 1, MaxFailures: 1, Allowance: sdkmath.ZeroInt()},
+					{Id: 2, Contract: suite.address, Payer: payer, GasLimit: 100000, Interval: 10, MaxFailures: 1, Disabled: true, Allowance: sdkmath.NewInt(1000)},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "duplicated scheduled call",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ScheduledCalls: []ScheduledCall{
+					{Id: 1, Contract: suite.address, Payer: payer, GasLimit: 100000, Interval: 1, MaxFailures: 1, Allowance: sdkmath.ZeroInt()},
+					{Id: 1, Contract: suite.address, Payer: payer, GasLimit: 100000, Interval: 1, MaxFailures: 1, Allowance: sdkmath.ZeroInt()},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid scheduled call",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ScheduledCalls: []ScheduledCall{
+					{Id: 1, Contract: suite.address, Payer: payer, GasLimit: 100000, MaxFailures: 1, Allowance: sdkmath.ZeroInt()},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "negative scheduled call allowance",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ScheduledCalls: []ScheduledCall{
+					{Id: 1, Contract: suite.address, Payer: payer, GasLimit: 100000, Interval: 1, MaxFailures: 1, Allowance: sdkmath.NewInt(-1)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid create factories",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				CreateFactories: []string{suite.address},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid create factory",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				CreateFactories: []string{"0x1234"},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
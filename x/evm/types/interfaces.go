@@ -19,6 +19,7 @@ import (
 	context "context"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
@@ -46,7 +47,9 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	authtypes.BankKeeper
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	BlockedAddr(addr sdk.AccAddress) bool
@@ -63,6 +66,14 @@ type FeeMarketKeeper interface {
 	GetBaseFee(ctx sdk.Context) *big.Int
 	GetParams(ctx sdk.Context) feemarkettypes.Params
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
+	AddTransientBurnedBaseFee(ctx sdk.Context, amount *big.Int) *big.Int
+}
+
+// FeeRateOracle provides the conversion rates of the alternative fee denominations.
+type FeeRateOracle interface {
+	// GetFeeRate returns the amount of denom equivalent to one unit of the evm denom,
+	// ok is false if no rate is available for the denom.
+	GetFeeRate(ctx sdk.Context, denom string) (rate sdkmath.LegacyDec, ok bool)
 }
 
 // Event Hooks
@@ -74,6 +85,13 @@ type EvmHooks interface {
 	PostTxProcessing(ctx sdk.Context, msg *core.Message, receipt *ethtypes.Receipt) error
 }
 
+// EvmPreTxHooks is implemented by the evm hooks processing the message before its execution
+type EvmPreTxHooks interface {
+	// Called before the message is executed, the hook can modify the message, if return an error,
+	// the transaction is rejected.
+	PreTxProcessing(ctx sdk.Context, msg *core.Message) error
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
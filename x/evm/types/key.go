@@ -16,6 +16,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -34,6 +35,15 @@ const (
 
 	// RouterKey uses module name for routing
 	RouterKey = ModuleName
+
+	// ContractFundsName is the name of the module account paying the value of the
+	// MsgCallContract and MsgDeployContract messages, funded e.g. with a community
+	// pool spend, so that the proposal deposits held by the authority are never spent.
+	ContractFundsName = ModuleName + "_contract_funds"
+
+	// BlockHashHistorySize is the number of block hashes kept in the EVM state, it
+	// matches the EIP-2935 HISTORY_SERVE_WINDOW.
+	BlockHashHistorySize = 8192
 )
 
 // prefix bytes for the EVM persistent store
@@ -41,6 +51,14 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixIncarnation
+	prefixStaleStorage
+	prefixBlockHash
+	prefixScheduledCall
+	prefixScheduledCallID
+	prefixBankAllowance
+	prefixCreateFactory
+	prefixScheduledCallQueue
 )
 
 // prefix bytes for the EVM transient store
@@ -49,29 +67,108 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientFeePayment
+	prefixTransientBlockGasUsed
+	prefixTransientFeePayer
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixCode    = []byte{prefixCode}
-	KeyPrefixStorage = []byte{prefixStorage}
-	KeyPrefixParams  = []byte{prefixParams}
+	KeyPrefixCode         = []byte{prefixCode}
+	KeyPrefixStorage      = []byte{prefixStorage}
+	KeyPrefixParams       = []byte{prefixParams}
+	KeyPrefixIncarnation  = []byte{prefixIncarnation}
+	KeyPrefixStaleStorage = []byte{prefixStaleStorage}
+	KeyPrefixBlockHash    = []byte{prefixBlockHash}
+
+	KeyPrefixScheduledCall = []byte{prefixScheduledCall}
+	// KeyScheduledCallID is the key of the next scheduled call id
+	KeyScheduledCallID = []byte{prefixScheduledCallID}
+
+	KeyPrefixBankAllowance = []byte{prefixBankAllowance}
+	KeyPrefixCreateFactory = []byte{prefixCreateFactory}
+
+	// KeyPrefixScheduledCallQueue indexes the enabled scheduled calls by next height
+	KeyPrefixScheduledCallQueue = []byte{prefixScheduledCallQueue}
 )
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom        = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex      = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize      = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed      = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientFeePayment   = []byte{prefixTransientFeePayment}
+	KeyPrefixTransientBlockGasUsed = []byte{prefixTransientBlockGasUsed}
+	KeyPrefixTransientFeePayer     = []byte{prefixTransientFeePayer}
 )
 
-// AddressStoragePrefix returns a prefix to iterate over a given account storage.
-func AddressStoragePrefix(address common.Address) []byte {
-	return append(KeyPrefixStorage, address.Bytes()...)
+// AddressStoragePrefix returns a prefix to iterate over the storage of the given
+// account incarnation.
+func AddressStoragePrefix(address common.Address, incarnation uint64) []byte {
+	prefix := append(KeyPrefixStorage, address.Bytes()...)
+	return append(prefix, sdk.Uint64ToBigEndian(incarnation)...)
 }
 
 // StateKey defines the full key under which an account state is stored.
-func StateKey(address common.Address, key []byte) []byte {
-	return append(AddressStoragePrefix(address), key...)
+func StateKey(address common.Address, incarnation uint64, key []byte) []byte {
+	return append(AddressStoragePrefix(address, incarnation), key...)
+}
+
+// IncarnationKey defines the key under which the current storage incarnation of an
+// account is stored.
+func IncarnationKey(address common.Address) []byte {
+	return append(KeyPrefixIncarnation, address.Bytes()...)
+}
+
+// StaleStorageKey defines the key marking the storage of an account incarnation as
+// stale, i.e. pending for garbage collection.
+func StaleStorageKey(address common.Address, incarnation uint64) []byte {
+	key := append(KeyPrefixStaleStorage, address.Bytes()...)
+	return append(key, sdk.Uint64ToBigEndian(incarnation)...)
+}
+
+// BlockHashKey defines the key of the block hash history ring buffer slot of the
+// given height.
+func BlockHashKey(height uint64) []byte {
+	return append(KeyPrefixBlockHash, sdk.Uint64ToBigEndian(height%BlockHashHistorySize)...)
+}
+
+// ScheduledCallKey defines the key under which a scheduled call is stored.
+func ScheduledCallKey(id uint64) []byte {
+	return append(KeyPrefixScheduledCall, sdk.Uint64ToBigEndian(id)...)
+}
+
+// ScheduledCallQueueKey defines the key indexing the enabled scheduled call of the given
+// id by the height of its next execution.
+func ScheduledCallQueueKey(nextHeight, id uint64) []byte {
+	key := append(KeyPrefixScheduledCallQueue, sdk.Uint64ToBigEndian(nextHeight)...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// BankAllowanceKey defines the key under which the allowance of the spender on the
+// owner funds is stored for the given bank precompile.
+func BankAllowanceKey(contract, owner, spender common.Address) []byte {
+	key := append(KeyPrefixBankAllowance, contract.Bytes()...)
+	key = append(key, owner.Bytes()...)
+	return append(key, spender.Bytes()...)
+}
+
+// CreateFactoryKey defines the key marking a contract as a create factory.
+func CreateFactoryKey(address common.Address) []byte {
+	return append(KeyPrefixCreateFactory, address.Bytes()...)
+}
+
+// SplitStaleStorageKey returns the account address and incarnation of a stale storage
+// key without its prefix.
+func SplitStaleStorageKey(key []byte) (common.Address, uint64) {
+	return common.BytesToAddress(key[:common.AddressLength]), sdk.BigEndianToUint64(key[common.AddressLength:])
+}
+
+// SplitStorageKey returns the account address, the incarnation and the slot of a
+// storage key without its prefix.
+func SplitStorageKey(key []byte) (common.Address, uint64, common.Hash) {
+	addr := common.BytesToAddress(key[:common.AddressLength])
+	incarnation := sdk.BigEndianToUint64(key[common.AddressLength : common.AddressLength+8])
+	return addr, incarnation, common.BytesToHash(key[common.AddressLength+8:])
 }
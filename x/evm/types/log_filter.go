@@ -0,0 +1,69 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// LogFilter selects the logs emitted by a set of contracts with a set of topics, following
+// the `eth_getLogs` filter semantics:
+//   - an empty address list matches any emitter
+//   - each topic position matches any of its hashes, an empty position matches any topic
+type LogFilter struct {
+	Addresses []common.Address
+	Topics    [][]common.Hash
+}
+
+// NewLogFilter returns a new LogFilter for the given addresses and topics.
+func NewLogFilter(addresses []common.Address, topics ...[]common.Hash) LogFilter {
+	return LogFilter{
+		Addresses: addresses,
+		Topics:    topics,
+	}
+}
+
+// Match returns true if the log matches the filter.
+func (f LogFilter) Match(log *ethtypes.Log) bool {
+	if len(f.Addresses) > 0 && !slices.Contains(f.Addresses, log.Address) {
+		return false
+	}
+
+	// the log must have at least as many topics as the filter positions
+	if len(f.Topics) > len(log.Topics) {
+		return false
+	}
+
+	for i, sub := range f.Topics {
+		if len(sub) > 0 && !slices.Contains(sub, log.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchAny returns true if at least one of the logs matches the filter.
+func (f LogFilter) MatchAny(logs []*ethtypes.Log) bool {
+	for _, log := range logs {
+		if f.Match(log) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/ethermint/tests"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestLogFilterMatch(t *testing.T) {
+	addr := tests.GenerateAddress()
+	topicA := common.BytesToHash([]byte("topicA"))
+	topicB := common.BytesToHash([]byte("topicB"))
+	log := &ethtypes.Log{
+		Address: addr,
+		Topics:  []common.Hash{topicA, topicB},
+	}
+
+	testCases := []struct {
+		name     string
+		filter   LogFilter
+		expMatch bool
+	}{
+		{
+			"empty filter",
+			NewLogFilter(nil),
+			true,
+		},
+		{
+			"matching address",
+			NewLogFilter([]common.Address{tests.GenerateAddress(), addr}),
+			true,
+		},
+		{
+			"other address",
+			NewLogFilter([]common.Address{tests.GenerateAddress()}),
+			false,
+		},
+		{
+			"matching topics",
+			NewLogFilter(nil, []common.Hash{topicA}, []common.Hash{topicA, topicB}),
+			true,
+		},
+		{
+			"wildcard topic position",
+			NewLogFilter([]common.Address{addr}, nil, []common.Hash{topicB}),
+			true,
+		},
+		{
+			"topic in the wrong position",
+			NewLogFilter(nil, []common.Hash{topicB}),
+			false,
+		},
+		{
+			"more topics than the log",
+			NewLogFilter(nil, nil, nil, []common.Hash{topicA}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expMatch, tc.filter.Match(log))
+			require.Equal(t, tc.expMatch, tc.filter.MatchAny([]*ethtypes.Log{{}, log}))
+		})
+	}
+}
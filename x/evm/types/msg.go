@@ -45,6 +45,12 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgCallContract{}
+	_ sdk.Msg    = &MsgDeployContract{}
+	_ sdk.Msg    = &MsgRegisterScheduledCall{}
+	_ sdk.Msg    = &MsgCancelScheduledCall{}
+	_ sdk.Msg    = &MsgApproveScheduledCall{}
+	_ sdk.Msg    = &MsgUpdateCreateFactories{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -446,3 +452,135 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCallContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !common.IsHexAddress(m.Contract) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract address %s", m.Contract)
+	}
+
+	return validateContractMsg(m.Value, m.GasLimit)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCallContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDeployContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.Data) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty contract init code")
+	}
+
+	return validateContractMsg(m.Value, m.GasLimit)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgDeployContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterScheduledCall) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.ScheduledCall(0, m.StartHeight).Validate()
+}
+
+// ScheduledCall returns the scheduled call registered by the message with the given id and first
+// execution height.
+func (m MsgRegisterScheduledCall) ScheduledCall(id, nextHeight uint64) ScheduledCall {
+	return ScheduledCall{
+		Id:          id,
+		Contract:    m.Contract,
+		Data:        m.Data,
+		GasLimit:    m.GasLimit,
+		Payer:       m.Payer,
+		Interval:    m.Interval,
+		NextHeight:  nextHeight,
+		MaxFailures: m.MaxFailures,
+		Allowance:   sdkmath.ZeroInt(),
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterScheduledCall) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCancelScheduledCall) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCancelScheduledCall) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgApproveScheduledCall) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Payer); err != nil {
+		return errorsmod.Wrap(err, "invalid payer address")
+	}
+
+	if m.Allowance.IsNil() || m.Allowance.IsNegative() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid allowance %s", m.Allowance)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgApproveScheduledCall) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateCreateFactories) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return errorsmod.Wrap(err, "invalid admin address")
+	}
+
+	if len(m.Add) == 0 && len(m.Remove) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "no factory to add or remove")
+	}
+	if err := validateAddresses("create factory", append(append([]string{}, m.Add...), m.Remove...)); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateCreateFactories) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// validateContractMsg checks the value and gas limit of a contract call or deployment.
+func validateContractMsg(value sdkmath.Int, gasLimit uint64) error {
+	if !value.IsNil() && value.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "negative value %s", value)
+	}
+
+	if gasLimit == 0 {
+		return errorsmod.Wrap(ErrInvalidGasLimit, "gas limit must be positive")
+	}
+
+	return nil
+}
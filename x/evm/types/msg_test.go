@@ -788,6 +788,157 @@ func (suite *MsgsTestSuite) TestTransactionCoding() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgCallContract_ValidateBasic() {
+	authority := sdk.AccAddress(suite.from.Bytes()).String()
+
+	testCases := []struct {
+		msg    string
+		req    types.MsgCallContract
+		expErr bool
+	}{
+		{"valid", types.MsgCallContract{Authority: authority, Contract: suite.to.Hex(), GasLimit: 100000}, false},
+		{"valid with value", types.MsgCallContract{Authority: authority, Contract: suite.to.Hex(), Value: sdkmath.NewInt(1), GasLimit: 100000}, false},
+		{"invalid authority", types.MsgCallContract{Authority: "foobar", Contract: suite.to.Hex(), GasLimit: 100000}, true},
+		{"invalid contract", types.MsgCallContract{Authority: authority, Contract: invalidFromAddress, GasLimit: 100000}, true},
+		{"negative value", types.MsgCallContract{Authority: authority, Contract: suite.to.Hex(), Value: sdkmath.NewInt(-1), GasLimit: 100000}, true},
+		{"zero gas limit", types.MsgCallContract{Authority: authority, Contract: suite.to.Hex()}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.req.ValidateBasic()
+		if tc.expErr {
+			suite.Require().Error(err, tc.msg)
+		} else {
+			suite.Require().NoError(err, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgDeployContract_ValidateBasic() {
+	authority := sdk.AccAddress(suite.from.Bytes()).String()
+	initCode := []byte{0x60, 0x00}
+
+	testCases := []struct {
+		msg    string
+		req    types.MsgDeployContract
+		expErr bool
+	}{
+		{"valid", types.MsgDeployContract{Authority: authority, Data: initCode, GasLimit: 100000}, false},
+		{"invalid authority", types.MsgDeployContract{Authority: "foobar", Data: initCode, GasLimit: 100000}, true},
+		{"empty init code", types.MsgDeployContract{Authority: authority, GasLimit: 100000}, true},
+		{"negative value", types.MsgDeployContract{Authority: authority, Data: initCode, Value: sdkmath.NewInt(-1), GasLimit: 100000}, true},
+		{"zero gas limit", types.MsgDeployContract{Authority: authority, Data: initCode}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.req.ValidateBasic()
+		if tc.expErr {
+			suite.Require().Error(err, tc.msg)
+		} else {
+			suite.Require().NoError(err, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterScheduledCall_ValidateBasic() {
+	authority := sdk.AccAddress(suite.from.Bytes()).String()
+	valid := types.MsgRegisterScheduledCall{
+		Authority:   authority,
+		Contract:    suite.to.Hex(),
+		GasLimit:    100000,
+		Payer:       authority,
+		Interval:    10,
+		MaxFailures: 3,
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func(*types.MsgRegisterScheduledCall)
+		expErr   bool
+	}{
+		{"valid", func(*types.MsgRegisterScheduledCall) {}, false},
+		{"invalid authority", func(m *types.MsgRegisterScheduledCall) { m.Authority = "foobar" }, true},
+		{"invalid contract", func(m *types.MsgRegisterScheduledCall) { m.Contract = invalidFromAddress }, true},
+		{"invalid payer", func(m *types.MsgRegisterScheduledCall) { m.Payer = "" }, true},
+		{"zero gas limit", func(m *types.MsgRegisterScheduledCall) { m.GasLimit = 0 }, true},
+		{"zero interval", func(m *types.MsgRegisterScheduledCall) { m.Interval = 0 }, true},
+		{"zero max failures", func(m *types.MsgRegisterScheduledCall) { m.MaxFailures = 0 }, true},
+	}
+
+	for _, tc := range testCases {
+		req := valid
+		tc.malleate(&req)
+		err := req.ValidateBasic()
+		if tc.expErr {
+			suite.Require().Error(err, tc.msg)
+		} else {
+			suite.Require().NoError(err, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgApproveScheduledCall_ValidateBasic() {
+	valid := types.MsgApproveScheduledCall{
+		Payer:     sdk.AccAddress(suite.from.Bytes()).String(),
+		Id:        1,
+		Allowance: sdkmath.NewInt(1000),
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func(*types.MsgApproveScheduledCall)
+		expErr   bool
+	}{
+		{"valid", func(*types.MsgApproveScheduledCall) {}, false},
+		{"zero allowance", func(m *types.MsgApproveScheduledCall) { m.Allowance = sdkmath.ZeroInt() }, false},
+		{"invalid payer", func(m *types.MsgApproveScheduledCall) { m.Payer = "foobar" }, true},
+		{"nil allowance", func(m *types.MsgApproveScheduledCall) { m.Allowance = sdkmath.Int{} }, true},
+		{"negative allowance", func(m *types.MsgApproveScheduledCall) { m.Allowance = sdkmath.NewInt(-1) }, true},
+	}
+
+	for _, tc := range testCases {
+		req := valid
+		tc.malleate(&req)
+		err := req.ValidateBasic()
+		if tc.expErr {
+			suite.Require().Error(err, tc.msg)
+		} else {
+			suite.Require().NoError(err, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateCreateFactories_ValidateBasic() {
+	valid := types.MsgUpdateCreateFactories{
+		Admin:  sdk.AccAddress(suite.from.Bytes()).String(),
+		Add:    []string{suite.to.Hex()},
+		Remove: []string{suite.from.Hex()},
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func(*types.MsgUpdateCreateFactories)
+		expErr   bool
+	}{
+		{"valid", func(*types.MsgUpdateCreateFactories) {}, false},
+		{"invalid admin", func(m *types.MsgUpdateCreateFactories) { m.Admin = "foobar" }, true},
+		{"invalid factory", func(m *types.MsgUpdateCreateFactories) { m.Add = []string{invalidFromAddress} }, true},
+		{"duplicate factory", func(m *types.MsgUpdateCreateFactories) { m.Remove = m.Add }, true},
+		{"no factory", func(m *types.MsgUpdateCreateFactories) { m.Add, m.Remove = nil, nil }, true},
+	}
+
+	for _, tc := range testCases {
+		req := valid
+		tc.malleate(&req)
+		err := req.ValidateBasic()
+		if tc.expErr {
+			suite.Require().Error(err, tc.msg)
+		} else {
+			suite.Require().NoError(err, tc.msg)
+		}
+	}
+}
+
 func encodeDecodeBinary(tx *ethtypes.Transaction) (*types.MsgEthereumTx, error) {
 	data, err := tx.MarshalBinary()
 	if err != nil {
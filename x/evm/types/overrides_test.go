@@ -0,0 +1,44 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockOverridesApply(t *testing.T) {
+	coinbase := common.HexToAddress("0x1")
+	number := hexutil.Big(*big.NewInt(10))
+	baseFee := hexutil.Big(*big.NewInt(100))
+	timestamp := hexutil.Uint64(1000)
+	gasLimit := hexutil.Uint64(30_000_000)
+
+	blockCtx := vm.BlockContext{
+		BlockNumber: big.NewInt(1),
+		Time:        1,
+		BaseFee:     big.NewInt(1),
+		GasLimit:    1,
+	}
+
+	var nilOverrides *BlockOverrides
+	nilOverrides.Apply(&blockCtx)
+	require.Equal(t, big.NewInt(1), blockCtx.BlockNumber)
+
+	overrides := &BlockOverrides{
+		Number:   &number,
+		Time:     &timestamp,
+		Coinbase: &coinbase,
+		BaseFee:  &baseFee,
+		GasLimit: &gasLimit,
+	}
+	overrides.Apply(&blockCtx)
+	require.Equal(t, big.NewInt(10), blockCtx.BlockNumber)
+	require.Equal(t, uint64(1000), blockCtx.Time)
+	require.Equal(t, coinbase, blockCtx.Coinbase)
+	require.Equal(t, big.NewInt(100), blockCtx.BaseFee)
+	require.Equal(t, uint64(30_000_000), blockCtx.GasLimit)
+}
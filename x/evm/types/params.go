@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -35,6 +36,9 @@ var (
 	DefaultEnableCreate = true
 	// DefaultEnableCall enables contract calls (i.e true)
 	DefaultEnableCall = true
+	// DefaultScheduledCallsGasLimit is the default total gas limit of the scheduled calls
+	// executed in a block
+	DefaultScheduledCallsGasLimit uint64 = 10_000_000
 )
 
 // AvailableExtraEIPs define the list of all EIPs that can be enabled by the
@@ -60,12 +64,13 @@ func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bo
 // ExtraEIPs is empty to prevent overriding the latest hard fork instruction set
 func DefaultParams() Params {
 	return Params{
-		EvmDenom:            DefaultEVMDenom,
-		EnableCreate:        DefaultEnableCreate,
-		EnableCall:          DefaultEnableCall,
-		ChainConfig:         DefaultChainConfig(),
-		ExtraEIPs:           nil,
-		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
+		EvmDenom:               DefaultEVMDenom,
+		EnableCreate:           DefaultEnableCreate,
+		EnableCall:             DefaultEnableCall,
+		ChainConfig:            DefaultChainConfig(),
+		ExtraEIPs:              nil,
+		AllowUnprotectedTxs:    DefaultAllowUnprotectedTxs,
+		ScheduledCallsGasLimit: DefaultScheduledCallsGasLimit,
 	}
 }
 
@@ -91,6 +96,30 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePrecompiles(p.ActivePrecompiles); err != nil {
+		return err
+	}
+
+	if err := validateCreateAllowlist(p.CreateAllowlist); err != nil {
+		return err
+	}
+
+	if err := validateBlockedAddresses(p.BlockedAddresses); err != nil {
+		return err
+	}
+
+	if err := validateCreateFactoryAdmin(p.CreateFactoryAdmin); err != nil {
+		return err
+	}
+
+	if err := validateFeeDenoms(p.FeeDenoms, p.EvmDenom); err != nil {
+		return err
+	}
+
+	if err := validateBankPrecompileDenoms(p.BankPrecompileDenoms, p.EvmDenom); err != nil {
+		return err
+	}
+
 	return validateChainConfig(p.ChainConfig)
 }
 
@@ -103,6 +132,49 @@ func (p Params) EIPs() []int {
 	return eips
 }
 
+// ActivePrecompileAddresses returns the addresses of the active precompiled contracts
+func (p Params) ActivePrecompileAddresses() []common.Address {
+	addresses := make([]common.Address, len(p.ActivePrecompiles))
+	for i, precompile := range p.ActivePrecompiles {
+		addresses[i] = common.HexToAddress(precompile)
+	}
+	return addresses
+}
+
+// IsCreateAllowed returns true if the address is allowed to deploy contracts, every
+// address is allowed when the create allowlist is empty.
+func (p Params) IsCreateAllowed(addr common.Address) bool {
+	if len(p.CreateAllowlist) == 0 {
+		return true
+	}
+	for _, allowed := range p.CreateAllowlist {
+		if common.HexToAddress(allowed) == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBlockedAddress returns true if the address is blocked in the EVM.
+func (p Params) IsBlockedAddress(addr common.Address) bool {
+	for _, blocked := range p.BlockedAddresses {
+		if common.HexToAddress(blocked) == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// EVMBlockGasLimit returns the gas limit of the EVM transactions in a block given the
+// consensus block gas limit, the block gas limit param can only lower the latter. Zero
+// means that no limit is set.
+func (p Params) EVMBlockGasLimit(blockGasLimit uint64) uint64 {
+	if p.BlockGasLimit == 0 || (blockGasLimit != 0 && blockGasLimit < p.BlockGasLimit) {
+		return blockGasLimit
+	}
+	return p.BlockGasLimit
+}
+
 func validateEVMDenom(i interface{}) error {
 	denom, ok := i.(string)
 	if !ok {
@@ -135,6 +207,104 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+func validatePrecompiles(i interface{}) error {
+	return validateAddresses("precompile", i)
+}
+
+func validateCreateAllowlist(i interface{}) error {
+	return validateAddresses("create allowlist", i)
+}
+
+func validateBlockedAddresses(i interface{}) error {
+	return validateAddresses("blocked", i)
+}
+
+func validateCreateFactoryAdmin(i interface{}) error {
+	admin, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid create factory admin type: %T", i)
+	}
+
+	if admin == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+		return fmt.Errorf("invalid create factory admin address %s: %w", admin, err)
+	}
+	return nil
+}
+
+// validateAddresses checks that the list contains unique hex addresses.
+func validateAddresses(kind string, i interface{}) error {
+	addresses, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid %s slice type: %T", kind, i)
+	}
+
+	seen := make(map[common.Address]bool, len(addresses))
+	for _, address := range addresses {
+		if !common.IsHexAddress(address) {
+			return fmt.Errorf("invalid %s address %s", kind, address)
+		}
+
+		addr := common.HexToAddress(address)
+		if seen[addr] {
+			return fmt.Errorf("duplicate %s address %s", kind, address)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+// validateFeeDenoms checks that the alternative fee denoms are unique, different from
+// the evm denom and have a positive conversion rate.
+func validateFeeDenoms(feeDenoms []FeeDenom, evmDenom string) error {
+	seen := make(map[string]bool, len(feeDenoms))
+	for _, feeDenom := range feeDenoms {
+		if err := sdk.ValidateDenom(feeDenom.Denom); err != nil {
+			return fmt.Errorf("invalid fee denom: %w", err)
+		}
+
+		if feeDenom.Denom == evmDenom {
+			return fmt.Errorf("fee denom %s is the evm denom", feeDenom.Denom)
+		}
+
+		if seen[feeDenom.Denom] {
+			return fmt.Errorf("duplicate fee denom %s", feeDenom.Denom)
+		}
+		seen[feeDenom.Denom] = true
+
+		if feeDenom.Rate.IsNil() || !feeDenom.Rate.IsPositive() {
+			return fmt.Errorf("fee denom %s rate must be positive: %s", feeDenom.Denom, feeDenom.Rate)
+		}
+	}
+
+	return nil
+}
+
+// validateBankPrecompileDenoms checks that the denoms of the bank precompiles are unique
+// and different from the evm denom, whose balances are managed by the StateDB.
+func validateBankPrecompileDenoms(denoms []string, evmDenom string) error {
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid bank precompile denom: %w", err)
+		}
+
+		if denom == evmDenom {
+			return fmt.Errorf("bank precompile denom %s is the evm denom", denom)
+		}
+
+		if seen[denom] {
+			return fmt.Errorf("duplicate bank precompile denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
 func validateChainConfig(i interface{}) error {
 	cfg, ok := i.(ChainConfig)
 	if !ok {
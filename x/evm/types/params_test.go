@@ -1,8 +1,11 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/stretchr/testify/require"
@@ -41,6 +44,120 @@ func TestParamsValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid precompile address",
+			Params{
+				EvmDenom:          "stake",
+				ChainConfig:       DefaultChainConfig(),
+				ActivePrecompiles: []string{"0x01"},
+			},
+			true,
+		},
+		{
+			"duplicate precompile address",
+			Params{
+				EvmDenom:          "stake",
+				ChainConfig:       DefaultChainConfig(),
+				ActivePrecompiles: []string{"0x0000000000000000000000000000000000000065", "0x0000000000000000000000000000000000000065"},
+			},
+			true,
+		},
+		{
+			"invalid create allowlist address",
+			Params{
+				EvmDenom:        "stake",
+				ChainConfig:     DefaultChainConfig(),
+				CreateAllowlist: []string{"ethm1"},
+			},
+			true,
+		},
+		{
+			"invalid create factory admin",
+			Params{
+				EvmDenom:           "stake",
+				ChainConfig:        DefaultChainConfig(),
+				CreateFactoryAdmin: "0x0000000000000000000000000000000000000001",
+			},
+			true,
+		},
+		{
+			"duplicate blocked address",
+			Params{
+				EvmDenom:         "stake",
+				ChainConfig:      DefaultChainConfig(),
+				BlockedAddresses: []string{"0x0000000000000000000000000000000000000001", "0x0000000000000000000000000000000000000001"},
+			},
+			true,
+		},
+		{
+			"valid fee denoms",
+			Params{
+				EvmDenom:    "stake",
+				ChainConfig: DefaultChainConfig(),
+				FeeDenoms: []FeeDenom{
+					{Denom: "uatom", Rate: sdkmath.LegacyNewDecWithPrec(5, 1)},
+					{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Rate: sdkmath.LegacyNewDec(2)},
+				},
+			},
+			false,
+		},
+		{
+			"fee denom is the evm denom",
+			Params{
+				EvmDenom:    "stake",
+				ChainConfig: DefaultChainConfig(),
+				FeeDenoms:   []FeeDenom{{Denom: "stake", Rate: sdkmath.LegacyOneDec()}},
+			},
+			true,
+		},
+		{
+			"duplicate fee denom",
+			Params{
+				EvmDenom:    "stake",
+				ChainConfig: DefaultChainConfig(),
+				FeeDenoms: []FeeDenom{
+					{Denom: "uatom", Rate: sdkmath.LegacyOneDec()},
+					{Denom: "uatom", Rate: sdkmath.LegacyNewDec(2)},
+				},
+			},
+			true,
+		},
+		{
+			"zero fee denom rate",
+			Params{
+				EvmDenom:    "stake",
+				ChainConfig: DefaultChainConfig(),
+				FeeDenoms:   []FeeDenom{{Denom: "uatom", Rate: sdkmath.LegacyZeroDec()}},
+			},
+			true,
+		},
+		{
+			"valid bank precompile denoms",
+			Params{
+				EvmDenom:             "stake",
+				ChainConfig:          DefaultChainConfig(),
+				BankPrecompileDenoms: []string{"uatom", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"},
+			},
+			false,
+		},
+		{
+			"bank precompile denom is the evm denom",
+			Params{
+				EvmDenom:             "stake",
+				ChainConfig:          DefaultChainConfig(),
+				BankPrecompileDenoms: []string{"stake"},
+			},
+			true,
+		},
+		{
+			"duplicate bank precompile denom",
+			Params{
+				EvmDenom:             "stake",
+				ChainConfig:          DefaultChainConfig(),
+				BankPrecompileDenoms: []string{"uatom", "uatom"},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -62,6 +179,38 @@ func TestParamsEIPs(t *testing.T) {
 	require.Equal(t, []int([]int{2929, 1884, 1344}), actual)
 }
 
+func TestParamsIsCreateAllowed(t *testing.T) {
+	deployer := common.BigToAddress(big.NewInt(1))
+	params := DefaultParams()
+	require.True(t, params.IsCreateAllowed(deployer))
+
+	params.CreateAllowlist = []string{common.BigToAddress(big.NewInt(2)).Hex()}
+	require.False(t, params.IsCreateAllowed(deployer))
+
+	params.CreateAllowlist = append(params.CreateAllowlist, deployer.Hex())
+	require.True(t, params.IsCreateAllowed(deployer))
+}
+
+func TestParamsIsBlockedAddress(t *testing.T) {
+	addr := common.BigToAddress(big.NewInt(1))
+	params := DefaultParams()
+	require.False(t, params.IsBlockedAddress(addr))
+
+	params.BlockedAddresses = []string{addr.Hex()}
+	require.True(t, params.IsBlockedAddress(addr))
+}
+
+func TestParamsEVMBlockGasLimit(t *testing.T) {
+	params := DefaultParams()
+	require.Equal(t, uint64(0), params.EVMBlockGasLimit(0))
+	require.Equal(t, uint64(30_000_000), params.EVMBlockGasLimit(30_000_000))
+
+	params.BlockGasLimit = 10_000_000
+	require.Equal(t, uint64(10_000_000), params.EVMBlockGasLimit(0))
+	require.Equal(t, uint64(10_000_000), params.EVMBlockGasLimit(30_000_000))
+	require.Equal(t, uint64(5_000_000), params.EVMBlockGasLimit(5_000_000))
+}
+
 func TestParamsValidatePriv(t *testing.T) {
 	require.Error(t, validateEVMDenom(false))
 	require.NoError(t, validateEVMDenom("inj"))
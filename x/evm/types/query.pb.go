@@ -797,6 +797,12 @@ type EthCallRequest struct {
 	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// overrides is the optional state overrides, in the same json format as the
+	// third parameter of the `eth_call` rpc api
+	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_overrides is the optional block header overrides, in the same json
+	// format as the fourth parameter of the `eth_call` rpc api
+	BlockOverrides []byte `protobuf:"bytes,6,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
@@ -860,6 +866,20 @@ func (m *EthCallRequest) GetChainId() int64 {
 	return 0
 }
 
+func (m *EthCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *EthCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -1208,6 +1228,179 @@ func (m *QueryTraceBlockResponse) GetData() []byte {
 	return nil
 }
 
+// QueryTraceCallRequest defines TraceCall request
+type QueryTraceCallRequest struct {
+	// args uses the same json format as the json rpc api.
+	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,3,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// block_number of the block the call is executed on top of
+	BlockNumber int64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_hash of the block the call is executed on top of
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_time of the block the call is executed on top of
+	BlockTime time.Time `protobuf:"bytes,6,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// proposer_address is the proposer of the block
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,7,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the the eip155 chain id parsed from the block header
+	ChainId int64 `protobuf:"varint,8,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// overrides uses the same json format as the state overrides of `debug_traceCall`.
+	Overrides []byte `protobuf:"bytes,9,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_overrides uses the same json format as the block overrides of `debug_traceCall`.
+	BlockOverrides []byte `protobuf:"bytes,10,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
+}
+
+func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
+func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallRequest) ProtoMessage()    {}
+func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{22}
+}
+func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallRequest.Merge(m, src)
+}
+func (m *QueryTraceCallRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallRequest proto.InternalMessageInfo
+
+func (m *QueryTraceCallRequest) GetArgs() []byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *QueryTraceCallRequest) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryTraceCallRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
+// QueryTraceCallResponse defines TraceCall response
+type QueryTraceCallResponse struct {
+	// data is the response serialized in bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryTraceCallResponse) Reset()         { *m = QueryTraceCallResponse{} }
+func (m *QueryTraceCallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallResponse) ProtoMessage()    {}
+func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{23}
+}
+func (m *QueryTraceCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallResponse.Merge(m, src)
+}
+func (m *QueryTraceCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallResponse proto.InternalMessageInfo
+
+func (m *QueryTraceCallResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // QueryBaseFeeRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBaseFeeRequest struct {
@@ -1217,7 +1410,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{22}
+	return fileDescriptor_e15a877459347994, []int{24}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1256,7 +1449,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{23}
+	return fileDescriptor_e15a877459347994, []int{25}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1285,212 +1478,529 @@ func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryAccountRequest)(nil), "ethermint.evm.v1.QueryAccountRequest")
-	proto.RegisterType((*QueryAccountResponse)(nil), "ethermint.evm.v1.QueryAccountResponse")
-	proto.RegisterType((*QueryCosmosAccountRequest)(nil), "ethermint.evm.v1.QueryCosmosAccountRequest")
-	proto.RegisterType((*QueryCosmosAccountResponse)(nil), "ethermint.evm.v1.QueryCosmosAccountResponse")
-	proto.RegisterType((*QueryValidatorAccountRequest)(nil), "ethermint.evm.v1.QueryValidatorAccountRequest")
-	proto.RegisterType((*QueryValidatorAccountResponse)(nil), "ethermint.evm.v1.QueryValidatorAccountResponse")
-	proto.RegisterType((*QueryBalanceRequest)(nil), "ethermint.evm.v1.QueryBalanceRequest")
-	proto.RegisterType((*QueryBalanceResponse)(nil), "ethermint.evm.v1.QueryBalanceResponse")
-	proto.RegisterType((*QueryStorageRequest)(nil), "ethermint.evm.v1.QueryStorageRequest")
-	proto.RegisterType((*QueryStorageResponse)(nil), "ethermint.evm.v1.QueryStorageResponse")
-	proto.RegisterType((*QueryCodeRequest)(nil), "ethermint.evm.v1.QueryCodeRequest")
-	proto.RegisterType((*QueryCodeResponse)(nil), "ethermint.evm.v1.QueryCodeResponse")
-	proto.RegisterType((*QueryTxLogsRequest)(nil), "ethermint.evm.v1.QueryTxLogsRequest")
-	proto.RegisterType((*QueryTxLogsResponse)(nil), "ethermint.evm.v1.QueryTxLogsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.evm.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.evm.v1.QueryParamsResponse")
-	proto.RegisterType((*EthCallRequest)(nil), "ethermint.evm.v1.EthCallRequest")
-	proto.RegisterType((*EstimateGasResponse)(nil), "ethermint.evm.v1.EstimateGasResponse")
-	proto.RegisterType((*QueryTraceTxRequest)(nil), "ethermint.evm.v1.QueryTraceTxRequest")
-	proto.RegisterType((*QueryTraceTxResponse)(nil), "ethermint.evm.v1.QueryTraceTxResponse")
-	proto.RegisterType((*QueryTraceBlockRequest)(nil), "ethermint.evm.v1.QueryTraceBlockRequest")
-	proto.RegisterType((*QueryTraceBlockResponse)(nil), "ethermint.evm.v1.QueryTraceBlockResponse")
-	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.evm.v1.QueryBaseFeeRequest")
-	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.evm.v1.QueryBaseFeeResponse")
+// QueryCreateAllowlistRequest defines the request type for querying the addresses
+// allowed to deploy contracts.
+type QueryCreateAllowlistRequest struct {
 }
 
-func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
-
-var fileDescriptor_e15a877459347994 = []byte{
-	// 1448 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xcf, 0xc6, 0x4e, 0xec, 0x8c, 0x03, 0xf8, 0x3b, 0x84, 0x2f, 0xce, 0x36, 0xb1, 0xc3, 0x42,
-	0x7e, 0x91, 0xb0, 0xdb, 0xb8, 0x08, 0xa9, 0x5c, 0x5a, 0x6c, 0x05, 0x4a, 0x81, 0x8a, 0xba, 0x51,
-	0x0f, 0x95, 0x2a, 0x6b, 0xbc, 0x1e, 0xd6, 0x56, 0xec, 0x1d, 0xb3, 0x33, 0x76, 0x1d, 0x28, 0x3d,
-	0x54, 0x2a, 0xa2, 0x42, 0xaa, 0x90, 0x7a, 0xaf, 0xf8, 0x0f, 0x7a, 0xec, 0xbf, 0xc0, 0x11, 0xa9,
-	0x97, 0xaa, 0x07, 0x8a, 0x48, 0x0f, 0xbd, 0xf5, 0xde, 0x53, 0x35, 0x3f, 0xd6, 0x5e, 0x7b, 0xbd,
-	0x76, 0xa8, 0xe8, 0xa9, 0xa7, 0xdd, 0x99, 0x79, 0xf3, 0xde, 0xe7, 0xfd, 0x98, 0xf7, 0x3e, 0x60,
-	0x09, 0xb3, 0x1a, 0xf6, 0x9a, 0x75, 0x97, 0x59, 0xb8, 0xd3, 0xb4, 0x3a, 0x3b, 0xd6, 0xdd, 0x36,
-	0xf6, 0x0e, 0xcc, 0x96, 0x47, 0x18, 0x81, 0xe9, 0xde, 0xa9, 0x89, 0x3b, 0x4d, 0xb3, 0xb3, 0xa3,
-	0x9f, 0xb7, 0x09, 0x6d, 0x12, 0x6a, 0x55, 0x10, 0xc5, 0x52, 0xd4, 0xea, 0xec, 0x54, 0x30, 0x43,
-	0x3b, 0x56, 0x0b, 0x39, 0x75, 0x17, 0xb1, 0x3a, 0x71, 0xe5, 0x6d, 0x5d, 0x0f, 0xe9, 0xe6, 0x4a,
-	0xe4, 0xd9, 0x62, 0xe8, 0x8c, 0x75, 0xd5, 0xd1, 0x82, 0x43, 0x1c, 0x22, 0x7e, 0x2d, 0xfe, 0xa7,
-	0x76, 0x97, 0x1c, 0x42, 0x9c, 0x06, 0xb6, 0x50, 0xab, 0x6e, 0x21, 0xd7, 0x25, 0x4c, 0x58, 0xa2,
-	0xea, 0x34, 0xa7, 0x4e, 0xc5, 0xaa, 0xd2, 0xbe, 0x63, 0xb1, 0x7a, 0x13, 0x53, 0x86, 0x9a, 0x2d,
-	0x29, 0x60, 0xbc, 0x0b, 0x4e, 0x7e, 0xcc, 0xd1, 0x5e, 0xb1, 0x6d, 0xd2, 0x76, 0x59, 0x09, 0xdf,
-	0x6d, 0x63, 0xca, 0x60, 0x06, 0x24, 0x50, 0xb5, 0xea, 0x61, 0x4a, 0x33, 0xda, 0x8a, 0xb6, 0x31,
-	0x57, 0xf2, 0x97, 0x97, 0x93, 0x8f, 0x9e, 0xe6, 0xa6, 0xfe, 0x78, 0x9a, 0x9b, 0x32, 0x6c, 0xb0,
-	0x30, 0x78, 0x95, 0xb6, 0x88, 0x4b, 0x31, 0xbf, 0x5b, 0x41, 0x0d, 0xe4, 0xda, 0xd8, 0xbf, 0xab,
-	0x96, 0xf0, 0x2d, 0x30, 0x67, 0x93, 0x2a, 0x2e, 0xd7, 0x10, 0xad, 0x65, 0xa6, 0xc5, 0x59, 0x92,
-	0x6f, 0x7c, 0x80, 0x68, 0x0d, 0x2e, 0x80, 0x19, 0x97, 0xf0, 0x4b, 0xb1, 0x15, 0x6d, 0x23, 0x5e,
-	0x92, 0x0b, 0xe3, 0x3d, 0xb0, 0x28, 0x8c, 0x14, 0x45, 0x78, 0xff, 0x01, 0xca, 0x87, 0x1a, 0xd0,
-	0x47, 0x69, 0x50, 0x60, 0x57, 0xc1, 0x71, 0x99, 0xb9, 0xf2, 0xa0, 0xa6, 0x63, 0x72, 0xf7, 0x8a,
-	0xdc, 0x84, 0x3a, 0x48, 0x52, 0x6e, 0x94, 0xe3, 0x9b, 0x16, 0xf8, 0x7a, 0x6b, 0xae, 0x02, 0x49,
-	0xad, 0x65, 0xb7, 0xdd, 0xac, 0x60, 0x4f, 0x79, 0x70, 0x4c, 0xed, 0x7e, 0x24, 0x36, 0x8d, 0x1b,
-	0x60, 0x49, 0xe0, 0xf8, 0x14, 0x35, 0xea, 0x55, 0xc4, 0x88, 0x37, 0xe4, 0xcc, 0x19, 0x30, 0x6f,
-	0x13, 0x77, 0x18, 0x47, 0x8a, 0xef, 0x5d, 0x09, 0x79, 0xf5, 0x58, 0x03, 0xcb, 0x11, 0xda, 0x94,
-	0x63, 0xeb, 0xe0, 0x84, 0x8f, 0x6a, 0x50, 0xa3, 0x0f, 0xf6, 0x0d, 0xba, 0xe6, 0x17, 0x51, 0x41,
-	0xe6, 0xf9, 0x75, 0xd2, 0xf3, 0xb6, 0x2a, 0xa2, 0xde, 0xd5, 0x49, 0x45, 0x64, 0xdc, 0x50, 0xc6,
-	0x3e, 0x61, 0xc4, 0x43, 0xce, 0x64, 0x63, 0x30, 0x0d, 0x62, 0xfb, 0xf8, 0x40, 0xd5, 0x1b, 0xff,
-	0x0d, 0x98, 0xdf, 0x56, 0xe6, 0x7b, 0xca, 0x94, 0xf9, 0x05, 0x30, 0xd3, 0x41, 0x8d, 0xb6, 0x6f,
-	0x5c, 0x2e, 0x8c, 0x4b, 0x20, 0xad, 0x4a, 0xa9, 0xfa, 0x5a, 0x4e, 0xae, 0x83, 0xff, 0x05, 0xee,
-	0x29, 0x13, 0x10, 0xc4, 0x79, 0xed, 0x8b, 0x5b, 0xf3, 0x25, 0xf1, 0x6f, 0xdc, 0x03, 0x50, 0x08,
-	0xee, 0x75, 0x6f, 0x12, 0x87, 0xfa, 0x26, 0x20, 0x88, 0x8b, 0x17, 0x23, 0xf5, 0x8b, 0x7f, 0x78,
-	0x15, 0x80, 0x7e, 0x5f, 0x11, 0xbe, 0xa5, 0xf2, 0x6b, 0xa6, 0x2c, 0x5a, 0x93, 0x37, 0x21, 0x53,
-	0xf6, 0x2b, 0xd5, 0x84, 0xcc, 0xdb, 0xfd, 0x50, 0x95, 0x02, 0x37, 0x03, 0x20, 0xbf, 0xd5, 0x54,
-	0x60, 0x7d, 0xe3, 0x0a, 0xe7, 0x26, 0x88, 0x37, 0x88, 0xc3, 0xbd, 0x8b, 0x6d, 0xa4, 0xf2, 0xa7,
-	0xcc, 0xe1, 0xd6, 0x67, 0xde, 0x24, 0x4e, 0x49, 0x88, 0xc0, 0x6b, 0x23, 0x40, 0xad, 0x4f, 0x04,
-	0x25, 0xed, 0x04, 0x51, 0x19, 0x0b, 0x2a, 0x0e, 0xb7, 0x91, 0x87, 0x9a, 0x7e, 0x1c, 0x8c, 0x5b,
-	0x0a, 0xa0, 0xbf, 0xab, 0x00, 0x5e, 0x02, 0xb3, 0x2d, 0xb1, 0x23, 0x02, 0x94, 0xca, 0x67, 0xc2,
-	0x10, 0xe5, 0x8d, 0x42, 0xfc, 0xd9, 0x8b, 0xdc, 0x54, 0x49, 0x49, 0x1b, 0x3f, 0x69, 0xe0, 0xf8,
-	0x2e, 0xab, 0x15, 0x51, 0xa3, 0x11, 0x88, 0x34, 0xf2, 0x1c, 0xea, 0xe7, 0x84, 0xff, 0xc3, 0xd3,
-	0x20, 0xe1, 0x20, 0x5a, 0xb6, 0x51, 0x4b, 0x3d, 0x8f, 0x59, 0x07, 0xd1, 0x22, 0x6a, 0xc1, 0xcf,
-	0x41, 0xba, 0xe5, 0x91, 0x16, 0xa1, 0xd8, 0xeb, 0x3d, 0x31, 0xfe, 0x3c, 0xe6, 0x0b, 0xf9, 0xbf,
-	0x5e, 0xe4, 0x4c, 0xa7, 0xce, 0x6a, 0xed, 0x8a, 0x69, 0x93, 0xa6, 0xa5, 0x66, 0x83, 0xfc, 0x5c,
-	0xa0, 0xd5, 0x7d, 0x8b, 0x1d, 0xb4, 0x30, 0x35, 0x8b, 0xfd, 0xb7, 0x5d, 0x3a, 0xe1, 0xeb, 0xf2,
-	0xdf, 0xe5, 0x22, 0x48, 0xda, 0x35, 0x54, 0x77, 0xcb, 0xf5, 0x6a, 0x26, 0xbe, 0xa2, 0x6d, 0xc4,
-	0x4a, 0x09, 0xb1, 0xbe, 0x5e, 0x35, 0xd6, 0xc1, 0xc9, 0x5d, 0xca, 0xea, 0x4d, 0xc4, 0xf0, 0x35,
-	0xd4, 0x0f, 0x44, 0x1a, 0xc4, 0x1c, 0x24, 0xc1, 0xc7, 0x4b, 0xfc, 0xd7, 0x78, 0x19, 0xf3, 0x73,
-	0xea, 0x21, 0x1b, 0xef, 0x75, 0x7d, 0x3f, 0x77, 0x40, 0xac, 0x49, 0x1d, 0x15, 0xaf, 0x5c, 0x38,
-	0x5e, 0xb7, 0xa8, 0xb3, 0xcb, 0xf7, 0x70, 0xbb, 0xb9, 0xd7, 0x2d, 0x71, 0x59, 0xf8, 0x3e, 0x98,
-	0x67, 0x5c, 0x49, 0xd9, 0x26, 0xee, 0x9d, 0xba, 0x23, 0x3c, 0x4d, 0xe5, 0x97, 0xc3, 0x77, 0x85,
-	0xa9, 0xa2, 0x10, 0x2a, 0xa5, 0x58, 0x7f, 0x01, 0x8b, 0x60, 0xbe, 0xe5, 0xe1, 0x2a, 0xb6, 0x31,
-	0xa5, 0xc4, 0xa3, 0x99, 0xb8, 0x28, 0xa8, 0x89, 0xd6, 0x07, 0x2e, 0xf1, 0x2e, 0x59, 0x69, 0x10,
-	0x7b, 0xdf, 0xef, 0x47, 0x33, 0x22, 0x32, 0x29, 0xb1, 0x27, 0xbb, 0x11, 0x5c, 0x06, 0x40, 0x8a,
-	0x88, 0x47, 0x33, 0x2b, 0x1e, 0xcd, 0x9c, 0xd8, 0x11, 0x73, 0xa6, 0xe8, 0x1f, 0xf3, 0x51, 0x98,
-	0x49, 0x08, 0x37, 0x74, 0x53, 0xce, 0x49, 0xd3, 0x9f, 0x93, 0xe6, 0x9e, 0x3f, 0x27, 0x0b, 0x49,
-	0x5e, 0x34, 0x4f, 0x7e, 0xcb, 0x69, 0x4a, 0x09, 0x3f, 0x19, 0x99, 0xfb, 0xe4, 0xbf, 0x93, 0xfb,
-	0xb9, 0x81, 0xdc, 0x7f, 0x18, 0x4f, 0x4e, 0xa7, 0x63, 0xa5, 0x24, 0xeb, 0x96, 0xeb, 0x6e, 0x15,
-	0x77, 0x8d, 0xf3, 0xaa, 0x83, 0xf5, 0x32, 0xdc, 0x6f, 0x2f, 0x55, 0xc4, 0x90, 0x5f, 0xca, 0xfc,
-	0xdf, 0xf8, 0x2e, 0x06, 0xfe, 0xdf, 0x17, 0x2e, 0x70, 0x6f, 0x02, 0x15, 0xc1, 0xba, 0xfe, 0x23,
-	0x9f, 0x5c, 0x11, 0xac, 0x4b, 0xdf, 0x40, 0x45, 0xfc, 0xd7, 0x93, 0x69, 0x5c, 0x00, 0xa7, 0x43,
-	0xf9, 0x18, 0x93, 0xbf, 0x53, 0xbd, 0x39, 0x4b, 0xf1, 0x55, 0xec, 0xf7, 0x73, 0xe3, 0x66, 0x6f,
-	0x86, 0xaa, 0x6d, 0xa5, 0xe2, 0x22, 0x48, 0xf2, 0xa6, 0x5b, 0xbe, 0x83, 0xd5, 0x1c, 0x2b, 0x2c,
-	0xfe, 0xfa, 0x22, 0x77, 0x4a, 0xa2, 0xa7, 0xd5, 0x7d, 0xb3, 0x4e, 0xac, 0x26, 0x62, 0x35, 0xf3,
-	0xba, 0xcb, 0xf8, 0x7c, 0x15, 0xb7, 0xf3, 0x7f, 0xce, 0x83, 0x19, 0xa1, 0x0e, 0x7e, 0xa3, 0x81,
-	0x84, 0xa2, 0x15, 0x70, 0x35, 0x9c, 0xd6, 0x11, 0xbc, 0x51, 0x5f, 0x9b, 0x24, 0x26, 0xa1, 0x19,
-	0x5b, 0x5f, 0xff, 0xfc, 0xfb, 0xf7, 0xd3, 0xab, 0xf0, 0xac, 0x15, 0xe2, 0xbb, 0x8a, 0x5a, 0x58,
-	0xf7, 0x55, 0x2a, 0x1e, 0xc0, 0x1f, 0x34, 0x70, 0x6c, 0x80, 0xbd, 0xc1, 0xad, 0x08, 0x33, 0xa3,
-	0x58, 0xa2, 0xbe, 0x7d, 0x34, 0x61, 0x85, 0x2c, 0x2f, 0x90, 0x6d, 0xc3, 0xf3, 0x61, 0x64, 0x3e,
-	0x51, 0x0c, 0x01, 0xfc, 0x51, 0x03, 0xe9, 0x61, 0x22, 0x06, 0xcd, 0x08, 0xb3, 0x11, 0xfc, 0x4f,
-	0xb7, 0x8e, 0x2c, 0xaf, 0x90, 0x5e, 0x16, 0x48, 0x2f, 0xc2, 0x7c, 0x18, 0x69, 0xc7, 0xbf, 0xd3,
-	0x07, 0x1b, 0xe4, 0x96, 0x0f, 0xe0, 0x43, 0x0d, 0x24, 0x14, 0xe5, 0x8a, 0x4c, 0xed, 0x20, 0x9b,
-	0x8b, 0x4c, 0xed, 0x10, 0x73, 0x33, 0xb6, 0x05, 0xac, 0x35, 0x78, 0x2e, 0x0c, 0x4b, 0x51, 0x38,
-	0x1a, 0x08, 0xdd, 0x63, 0x0d, 0x24, 0x14, 0xf9, 0x8a, 0x04, 0x32, 0xc8, 0xf4, 0x22, 0x81, 0x0c,
-	0x71, 0x38, 0x63, 0x47, 0x00, 0xd9, 0x82, 0x9b, 0x61, 0x20, 0x54, 0x8a, 0xf6, 0x71, 0x58, 0xf7,
-	0xf7, 0xf1, 0xc1, 0x03, 0x78, 0x0f, 0xc4, 0x39, 0x47, 0x83, 0x46, 0x64, 0xc9, 0xf4, 0x88, 0x9f,
-	0x7e, 0x76, 0xac, 0x8c, 0xc2, 0xb0, 0x29, 0x30, 0x9c, 0x85, 0x67, 0x46, 0x55, 0x53, 0x75, 0x20,
-	0x12, 0x5f, 0x80, 0x59, 0x49, 0x53, 0xe0, 0xb9, 0x08, 0xcd, 0x03, 0x6c, 0x48, 0x5f, 0x9d, 0x20,
-	0xa5, 0x10, 0xac, 0x08, 0x04, 0x3a, 0xcc, 0x84, 0x11, 0x48, 0x1e, 0x04, 0xbb, 0x20, 0xa1, 0x68,
-	0x10, 0x5c, 0x09, 0xeb, 0x1c, 0x64, 0x48, 0xfa, 0xfa, 0xa4, 0xd1, 0xe0, 0xdb, 0x35, 0x84, 0xdd,
-	0x25, 0xa8, 0x87, 0xed, 0x62, 0x56, 0x2b, 0xdb, 0xdc, 0xdc, 0x57, 0x20, 0x15, 0xe0, 0x31, 0x47,
-	0xb0, 0x3e, 0xc2, 0xe7, 0x11, 0x44, 0xc8, 0x58, 0x13, 0xb6, 0x57, 0x60, 0x76, 0x84, 0x6d, 0x25,
-	0x5e, 0x76, 0x10, 0x85, 0x5f, 0x82, 0x84, 0x1a, 0x9b, 0x91, 0xb5, 0x37, 0x48, 0x9c, 0x22, 0x6b,
-	0x6f, 0x68, 0xfa, 0x8e, 0xf3, 0x5e, 0xce, 0x4c, 0xd6, 0x85, 0x8f, 0x34, 0x00, 0xfa, 0x8d, 0x1f,
-	0x6e, 0x8c, 0x53, 0x1d, 0x9c, 0xd5, 0xfa, 0xe6, 0x11, 0x24, 0x15, 0x8e, 0x55, 0x81, 0x23, 0x07,
-	0x97, 0xa3, 0x70, 0x88, 0x29, 0xc8, 0x03, 0xa1, 0x86, 0xc7, 0x98, 0x6e, 0x10, 0x9c, 0x39, 0x63,
-	0xba, 0xc1, 0xc0, 0x0c, 0x1a, 0x17, 0x08, 0x7f, 0x36, 0x15, 0x76, 0x9f, 0xbd, 0xca, 0x6a, 0xcf,
-	0x5f, 0x65, 0xb5, 0x97, 0xaf, 0xb2, 0xda, 0x93, 0xc3, 0xec, 0xd4, 0xf3, 0xc3, 0xec, 0xd4, 0x2f,
-	0x87, 0xd9, 0xa9, 0xcf, 0xb6, 0x02, 0xb3, 0xf7, 0x1e, 0x66, 0xe8, 0x82, 0x18, 0x9c, 0x01, 0x55,
-	0x5d, 0xa1, 0x4c, 0x0c, 0xe1, 0xca, 0xac, 0x98, 0xf7, 0xef, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff,
-	0xcc, 0xb0, 0x40, 0x4a, 0xbb, 0x11, 0x00, 0x00,
+func (m *QueryCreateAllowlistRequest) Reset()         { *m = QueryCreateAllowlistRequest{} }
+func (m *QueryCreateAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCreateAllowlistRequest) ProtoMessage()    {}
+func (*QueryCreateAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{26}
+}
+func (m *QueryCreateAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCreateAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCreateAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCreateAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCreateAllowlistRequest.Merge(m, src)
+}
+func (m *QueryCreateAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCreateAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCreateAllowlistRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryCreateAllowlistRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Account queries an Ethereum account.
-	Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error)
-	// CosmosAccount queries an Ethereum account's Cosmos Address.
-	CosmosAccount(ctx context.Context, in *QueryCosmosAccountRequest, opts ...grpc.CallOption) (*QueryCosmosAccountResponse, error)
-	// ValidatorAccount queries an Ethereum account's from a validator consensus
-	// Address.
-	ValidatorAccount(ctx context.Context, in *QueryValidatorAccountRequest, opts ...grpc.CallOption) (*QueryValidatorAccountResponse, error)
-	// Balance queries the balance of a the EVM denomination for a single
-	// EthAccount.
-	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
-	// Storage queries the balance of all coins for a single account.
-	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
-	// Code queries the balance of all coins for a single account.
-	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
-	// Params queries the parameters of x/evm module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// EthCall implements the `eth_call` rpc api
-	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
-	// EstimateGas implements the `eth_estimateGas` rpc api
-	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
-	// TraceTx implements the `debug_traceTransaction` rpc api
-	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
-	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
-	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
-	// BaseFee queries the base fee of the parent block of the current block,
-	// it's similar to feemarket module's method, but also checks london hardfork status.
-	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+// QueryCreateAllowlistResponse returns the addresses allowed to deploy contracts.
+type QueryCreateAllowlistResponse struct {
+	// addresses are the hex addresses allowed to deploy contracts, an empty list
+	// allows every address to deploy contracts.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// factories are the hex addresses of the factory contracts managed by the create
+	// factory admin, they are allowed to deploy contracts with CREATE/CREATE2.
+	Factories []string `protobuf:"bytes,2,rep,name=factories,proto3" json:"factories,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryCreateAllowlistResponse) Reset()         { *m = QueryCreateAllowlistResponse{} }
+func (m *QueryCreateAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCreateAllowlistResponse) ProtoMessage()    {}
+func (*QueryCreateAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{27}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryCreateAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error) {
-	out := new(QueryAccountResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Account", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCreateAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCreateAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCreateAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCreateAllowlistResponse.Merge(m, src)
+}
+func (m *QueryCreateAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCreateAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCreateAllowlistResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) CosmosAccount(ctx context.Context, in *QueryCosmosAccountRequest, opts ...grpc.CallOption) (*QueryCosmosAccountResponse, error) {
-	out := new(QueryCosmosAccountResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/CosmosAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCreateAllowlistResponse proto.InternalMessageInfo
+
+func (m *QueryCreateAllowlistResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ValidatorAccount(ctx context.Context, in *QueryValidatorAccountRequest, opts ...grpc.CallOption) (*QueryValidatorAccountResponse, error) {
-	out := new(QueryValidatorAccountResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/ValidatorAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCreateAllowlistResponse) GetFactories() []string {
+	if m != nil {
+		return m.Factories
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error) {
-	out := new(QueryBalanceResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Balance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
+// QueryBlockedAddressesRequest defines the request type for querying the addresses
+// blocked in the EVM.
+type QueryBlockedAddressesRequest struct {
+}
+
+func (m *QueryBlockedAddressesRequest) Reset()         { *m = QueryBlockedAddressesRequest{} }
+func (m *QueryBlockedAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAddressesRequest) ProtoMessage()    {}
+func (*QueryBlockedAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{28}
+}
+func (m *QueryBlockedAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAddressesRequest.Merge(m, src)
+}
+func (m *QueryBlockedAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAddressesRequest proto.InternalMessageInfo
+
+// QueryBlockedAddressesResponse returns the addresses blocked in the EVM.
+type QueryBlockedAddressesResponse struct {
+	// addresses are the hex addresses blocked in the EVM.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *QueryBlockedAddressesResponse) Reset()         { *m = QueryBlockedAddressesResponse{} }
+func (m *QueryBlockedAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAddressesResponse) ProtoMessage()    {}
+func (*QueryBlockedAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{29}
+}
+func (m *QueryBlockedAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAddressesResponse.Merge(m, src)
+}
+func (m *QueryBlockedAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryBlockedAddressesResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// QueryScheduledCallsRequest defines the request type for querying the scheduled
+// contract calls.
+type QueryScheduledCallsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledCallsRequest) Reset()         { *m = QueryScheduledCallsRequest{} }
+func (m *QueryScheduledCallsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledCallsRequest) ProtoMessage()    {}
+func (*QueryScheduledCallsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{30}
+}
+func (m *QueryScheduledCallsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledCallsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledCallsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledCallsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledCallsRequest.Merge(m, src)
+}
+func (m *QueryScheduledCallsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledCallsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledCallsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledCallsRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledCallsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryScheduledCallsResponse returns the scheduled contract calls.
+type QueryScheduledCallsResponse struct {
+	// calls are the scheduled contract calls ordered by id.
+	Calls []ScheduledCall `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledCallsResponse) Reset()         { *m = QueryScheduledCallsResponse{} }
+func (m *QueryScheduledCallsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledCallsResponse) ProtoMessage()    {}
+func (*QueryScheduledCallsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{31}
+}
+func (m *QueryScheduledCallsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledCallsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledCallsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledCallsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledCallsResponse.Merge(m, src)
+}
+func (m *QueryScheduledCallsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledCallsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledCallsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledCallsResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledCallsResponse) GetCalls() []ScheduledCall {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+func (m *QueryScheduledCallsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryAccountRequest)(nil), "ethermint.evm.v1.QueryAccountRequest")
+	proto.RegisterType((*QueryAccountResponse)(nil), "ethermint.evm.v1.QueryAccountResponse")
+	proto.RegisterType((*QueryCosmosAccountRequest)(nil), "ethermint.evm.v1.QueryCosmosAccountRequest")
+	proto.RegisterType((*QueryCosmosAccountResponse)(nil), "ethermint.evm.v1.QueryCosmosAccountResponse")
+	proto.RegisterType((*QueryValidatorAccountRequest)(nil), "ethermint.evm.v1.QueryValidatorAccountRequest")
+	proto.RegisterType((*QueryValidatorAccountResponse)(nil), "ethermint.evm.v1.QueryValidatorAccountResponse")
+	proto.RegisterType((*QueryBalanceRequest)(nil), "ethermint.evm.v1.QueryBalanceRequest")
+	proto.RegisterType((*QueryBalanceResponse)(nil), "ethermint.evm.v1.QueryBalanceResponse")
+	proto.RegisterType((*QueryStorageRequest)(nil), "ethermint.evm.v1.QueryStorageRequest")
+	proto.RegisterType((*QueryStorageResponse)(nil), "ethermint.evm.v1.QueryStorageResponse")
+	proto.RegisterType((*QueryCodeRequest)(nil), "ethermint.evm.v1.QueryCodeRequest")
+	proto.RegisterType((*QueryCodeResponse)(nil), "ethermint.evm.v1.QueryCodeResponse")
+	proto.RegisterType((*QueryTxLogsRequest)(nil), "ethermint.evm.v1.QueryTxLogsRequest")
+	proto.RegisterType((*QueryTxLogsResponse)(nil), "ethermint.evm.v1.QueryTxLogsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.evm.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.evm.v1.QueryParamsResponse")
+	proto.RegisterType((*EthCallRequest)(nil), "ethermint.evm.v1.EthCallRequest")
+	proto.RegisterType((*EstimateGasResponse)(nil), "ethermint.evm.v1.EstimateGasResponse")
+	proto.RegisterType((*QueryTraceTxRequest)(nil), "ethermint.evm.v1.QueryTraceTxRequest")
+	proto.RegisterType((*QueryTraceTxResponse)(nil), "ethermint.evm.v1.QueryTraceTxResponse")
+	proto.RegisterType((*QueryTraceBlockRequest)(nil), "ethermint.evm.v1.QueryTraceBlockRequest")
+	proto.RegisterType((*QueryTraceBlockResponse)(nil), "ethermint.evm.v1.QueryTraceBlockResponse")
+	proto.RegisterType((*QueryTraceCallRequest)(nil), "ethermint.evm.v1.QueryTraceCallRequest")
+	proto.RegisterType((*QueryTraceCallResponse)(nil), "ethermint.evm.v1.QueryTraceCallResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.evm.v1.QueryBaseFeeRequest")
+	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.evm.v1.QueryBaseFeeResponse")
+	proto.RegisterType((*QueryCreateAllowlistRequest)(nil), "ethermint.evm.v1.QueryCreateAllowlistRequest")
+	proto.RegisterType((*QueryCreateAllowlistResponse)(nil), "ethermint.evm.v1.QueryCreateAllowlistResponse")
+	proto.RegisterType((*QueryBlockedAddressesRequest)(nil), "ethermint.evm.v1.QueryBlockedAddressesRequest")
+	proto.RegisterType((*QueryBlockedAddressesResponse)(nil), "ethermint.evm.v1.QueryBlockedAddressesResponse")
+	proto.RegisterType((*QueryScheduledCallsRequest)(nil), "ethermint.evm.v1.QueryScheduledCallsRequest")
+	proto.RegisterType((*QueryScheduledCallsResponse)(nil), "ethermint.evm.v1.QueryScheduledCallsResponse")
+}
+
+func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
+
+var fileDescriptor_e15a877459347994 = []byte{
+	// 1769 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x4b, 0x6f, 0xdb, 0xca,
+	0x15, 0x36, 0x2d, 0xd9, 0x92, 0x46, 0x8e, 0xa3, 0x4c, 0xec, 0x46, 0x66, 0x6c, 0xc9, 0x61, 0xfc,
+	0x7e, 0x90, 0xb5, 0x1a, 0x04, 0x68, 0x8a, 0xa2, 0xb5, 0x0d, 0x27, 0x4d, 0x93, 0xb4, 0xa9, 0x62,
+	0x74, 0x11, 0xa0, 0x10, 0x46, 0xe4, 0x98, 0x22, 0x2c, 0x89, 0x0a, 0x87, 0x52, 0xe4, 0x3c, 0xba,
+	0x28, 0xda, 0x20, 0x45, 0x80, 0x22, 0x40, 0x37, 0x5d, 0x14, 0x45, 0x80, 0xfe, 0x80, 0xfe, 0x8d,
+	0xac, 0x8a, 0x00, 0xd9, 0x14, 0x5d, 0xe4, 0x06, 0xc9, 0x5d, 0xdc, 0xdf, 0x70, 0x17, 0x17, 0x17,
+	0xf3, 0xa0, 0x44, 0x8a, 0xa2, 0xa4, 0x04, 0xce, 0xea, 0xae, 0xc8, 0x39, 0x73, 0x1e, 0xdf, 0x9c,
+	0x73, 0xe6, 0xcc, 0x39, 0x60, 0x1e, 0xbb, 0x15, 0xec, 0xd4, 0xac, 0xba, 0xab, 0xe1, 0x56, 0x4d,
+	0x6b, 0xed, 0x68, 0x0f, 0x9a, 0xd8, 0x39, 0x51, 0x1b, 0x8e, 0xed, 0xda, 0x30, 0xd3, 0xd9, 0x55,
+	0x71, 0xab, 0xa6, 0xb6, 0x76, 0xe4, 0x0d, 0xdd, 0x26, 0x35, 0x9b, 0x68, 0x65, 0x44, 0x30, 0x67,
+	0xd5, 0x5a, 0x3b, 0x65, 0xec, 0xa2, 0x1d, 0xad, 0x81, 0x4c, 0xab, 0x8e, 0x5c, 0xcb, 0xae, 0x73,
+	0x69, 0x59, 0x0e, 0xe9, 0xa6, 0x4a, 0xf8, 0xde, 0x5c, 0x68, 0xcf, 0x6d, 0x8b, 0xad, 0x19, 0xd3,
+	0x36, 0x6d, 0xf6, 0xab, 0xd1, 0x3f, 0x41, 0x9d, 0x37, 0x6d, 0xdb, 0xac, 0x62, 0x0d, 0x35, 0x2c,
+	0x0d, 0xd5, 0xeb, 0xb6, 0xcb, 0x2c, 0x11, 0xb1, 0x9b, 0x17, 0xbb, 0x6c, 0x55, 0x6e, 0x1e, 0x69,
+	0xae, 0x55, 0xc3, 0xc4, 0x45, 0xb5, 0x06, 0x67, 0x50, 0x7e, 0x0a, 0xce, 0xff, 0x8e, 0xa2, 0xdd,
+	0xd5, 0x75, 0xbb, 0x59, 0x77, 0x8b, 0xf8, 0x41, 0x13, 0x13, 0x17, 0x66, 0x41, 0x02, 0x19, 0x86,
+	0x83, 0x09, 0xc9, 0x4a, 0x8b, 0xd2, 0x5a, 0xaa, 0xe8, 0x2d, 0xaf, 0x25, 0x9f, 0xbf, 0xca, 0x8f,
+	0x7d, 0xf3, 0x2a, 0x3f, 0xa6, 0xe8, 0x60, 0x26, 0x28, 0x4a, 0x1a, 0x76, 0x9d, 0x60, 0x2a, 0x5b,
+	0x46, 0x55, 0x54, 0xd7, 0xb1, 0x27, 0x2b, 0x96, 0xf0, 0x22, 0x48, 0xe9, 0xb6, 0x81, 0x4b, 0x15,
+	0x44, 0x2a, 0xd9, 0x71, 0xb6, 0x97, 0xa4, 0x84, 0x5f, 0x21, 0x52, 0x81, 0x33, 0x60, 0xa2, 0x6e,
+	0x53, 0xa1, 0xd8, 0xa2, 0xb4, 0x16, 0x2f, 0xf2, 0x85, 0xf2, 0x0b, 0x30, 0xc7, 0x8c, 0xec, 0x33,
+	0xf7, 0x7e, 0x06, 0xca, 0x67, 0x12, 0x90, 0xfb, 0x69, 0x10, 0x60, 0x97, 0xc1, 0x34, 0x8f, 0x5c,
+	0x29, 0xa8, 0xe9, 0x0c, 0xa7, 0xee, 0x72, 0x22, 0x94, 0x41, 0x92, 0x50, 0xa3, 0x14, 0xdf, 0x38,
+	0xc3, 0xd7, 0x59, 0x53, 0x15, 0x88, 0x6b, 0x2d, 0xd5, 0x9b, 0xb5, 0x32, 0x76, 0xc4, 0x09, 0xce,
+	0x08, 0xea, 0x6f, 0x18, 0x51, 0xb9, 0x05, 0xe6, 0x19, 0x8e, 0xdf, 0xa3, 0xaa, 0x65, 0x20, 0xd7,
+	0x76, 0x7a, 0x0e, 0x73, 0x09, 0x4c, 0xe9, 0x76, 0xbd, 0x17, 0x47, 0x9a, 0xd2, 0x76, 0x43, 0xa7,
+	0x7a, 0x21, 0x81, 0x85, 0x08, 0x6d, 0xe2, 0x60, 0xab, 0xe0, 0xac, 0x87, 0x2a, 0xa8, 0xd1, 0x03,
+	0x7b, 0x8a, 0x47, 0xf3, 0x92, 0x68, 0x8f, 0xc7, 0xf9, 0x53, 0xc2, 0xf3, 0x63, 0x91, 0x44, 0x1d,
+	0xd1, 0x61, 0x49, 0xa4, 0xdc, 0x12, 0xc6, 0xee, 0xb9, 0xb6, 0x83, 0xcc, 0xe1, 0xc6, 0x60, 0x06,
+	0xc4, 0x8e, 0xf1, 0x89, 0xc8, 0x37, 0xfa, 0xeb, 0x33, 0xbf, 0x25, 0xcc, 0x77, 0x94, 0x09, 0xf3,
+	0x33, 0x60, 0xa2, 0x85, 0xaa, 0x4d, 0xcf, 0x38, 0x5f, 0x28, 0x57, 0x41, 0x46, 0xa4, 0x92, 0xf1,
+	0x49, 0x87, 0x5c, 0x05, 0xe7, 0x7c, 0x72, 0xc2, 0x04, 0x04, 0x71, 0x9a, 0xfb, 0x4c, 0x6a, 0xaa,
+	0xc8, 0xfe, 0x95, 0x47, 0x00, 0x32, 0xc6, 0xc3, 0xf6, 0x6d, 0xdb, 0x24, 0x9e, 0x09, 0x08, 0xe2,
+	0xec, 0xc6, 0x70, 0xfd, 0xec, 0x1f, 0x5e, 0x07, 0xa0, 0x5b, 0x57, 0xd8, 0xd9, 0xd2, 0x85, 0x15,
+	0x95, 0x27, 0xad, 0x4a, 0x8b, 0x90, 0xca, 0xeb, 0x95, 0x28, 0x42, 0xea, 0xdd, 0xae, 0xab, 0x8a,
+	0x3e, 0x49, 0x1f, 0xc8, 0xbf, 0x4a, 0xc2, 0xb1, 0x9e, 0x71, 0x81, 0x73, 0x1d, 0xc4, 0xab, 0xb6,
+	0x49, 0x4f, 0x17, 0x5b, 0x4b, 0x17, 0x66, 0xd5, 0xde, 0xd2, 0xa7, 0xde, 0xb6, 0xcd, 0x22, 0x63,
+	0x81, 0x37, 0xfa, 0x80, 0x5a, 0x1d, 0x0a, 0x8a, 0xdb, 0xf1, 0xa3, 0x52, 0x66, 0x84, 0x1f, 0xee,
+	0x22, 0x07, 0xd5, 0x3c, 0x3f, 0x28, 0x77, 0x04, 0x40, 0x8f, 0x2a, 0x00, 0x5e, 0x05, 0x93, 0x0d,
+	0x46, 0x61, 0x0e, 0x4a, 0x17, 0xb2, 0x61, 0x88, 0x5c, 0x62, 0x2f, 0xfe, 0xfa, 0x5d, 0x7e, 0xac,
+	0x28, 0xb8, 0x95, 0xef, 0x24, 0x30, 0x7d, 0xe0, 0x56, 0xf6, 0x51, 0xb5, 0xea, 0xf3, 0x34, 0x72,
+	0x4c, 0xe2, 0xc5, 0x84, 0xfe, 0xc3, 0x0b, 0x20, 0x61, 0x22, 0x52, 0xd2, 0x51, 0x43, 0x5c, 0x8f,
+	0x49, 0x13, 0x91, 0x7d, 0xd4, 0x80, 0x7f, 0x00, 0x99, 0x86, 0x63, 0x37, 0x6c, 0x82, 0x9d, 0xce,
+	0x15, 0xa3, 0xd7, 0x63, 0x6a, 0xaf, 0xf0, 0xed, 0xbb, 0xbc, 0x6a, 0x5a, 0x6e, 0xa5, 0x59, 0x56,
+	0x75, 0xbb, 0xa6, 0x89, 0xb7, 0x81, 0x7f, 0xb6, 0x89, 0x71, 0xac, 0xb9, 0x27, 0x0d, 0x4c, 0xd4,
+	0xfd, 0xee, 0xdd, 0x2e, 0x9e, 0xf5, 0x74, 0x79, 0xf7, 0x72, 0x0e, 0x24, 0xf5, 0x0a, 0xb2, 0xea,
+	0x25, 0xcb, 0xc8, 0xc6, 0x17, 0xa5, 0xb5, 0x58, 0x31, 0xc1, 0xd6, 0x37, 0x0d, 0x38, 0x0f, 0x52,
+	0x76, 0x0b, 0x3b, 0x8e, 0x65, 0x60, 0x92, 0x9d, 0x60, 0x58, 0xbb, 0x04, 0x7a, 0xf3, 0xcb, 0x55,
+	0x5b, 0x3f, 0x2e, 0x75, 0x79, 0x26, 0x19, 0xcf, 0x34, 0x23, 0xff, 0xd6, 0xa3, 0x2a, 0xab, 0xe0,
+	0xfc, 0x01, 0x71, 0xad, 0x1a, 0x72, 0xf1, 0x0d, 0xd4, 0xf5, 0x67, 0x06, 0xc4, 0x4c, 0xc4, 0x7d,
+	0x10, 0x2f, 0xd2, 0x5f, 0xe5, 0x7d, 0xcc, 0x4b, 0x0d, 0x07, 0xe9, 0xf8, 0xb0, 0xed, 0xb9, 0x6b,
+	0x07, 0xc4, 0x6a, 0xc4, 0x14, 0x6e, 0xcf, 0x87, 0xdd, 0x7e, 0x87, 0x98, 0x07, 0x94, 0x86, 0x9b,
+	0xb5, 0xc3, 0x76, 0x91, 0xf2, 0xc2, 0x5f, 0x82, 0x29, 0x97, 0x2a, 0x29, 0xe9, 0x76, 0xfd, 0xc8,
+	0x32, 0x99, 0xc3, 0xd2, 0x85, 0x85, 0xb0, 0x2c, 0x33, 0xb5, 0xcf, 0x98, 0x8a, 0x69, 0xb7, 0xbb,
+	0x80, 0xfb, 0x60, 0xaa, 0xe1, 0x60, 0x03, 0xeb, 0x98, 0x10, 0xdb, 0x21, 0xd9, 0x38, 0xcb, 0xcb,
+	0xa1, 0xd6, 0x03, 0x42, 0xb4, 0xd8, 0x72, 0x1f, 0x89, 0xb2, 0x36, 0xc1, 0x1c, 0x9c, 0x66, 0x34,
+	0x5e, 0xd4, 0xe0, 0x02, 0x00, 0x9c, 0x85, 0xdd, 0xbd, 0x49, 0x76, 0xf7, 0x52, 0x8c, 0xc2, 0x9e,
+	0xab, 0x7d, 0x6f, 0x9b, 0xbe, 0xa8, 0xd9, 0x04, 0x3b, 0x86, 0xac, 0xf2, 0xe7, 0x56, 0xf5, 0x9e,
+	0x5b, 0xf5, 0xd0, 0x7b, 0x6e, 0xf7, 0x92, 0x34, 0xf7, 0x5e, 0x7e, 0x95, 0x97, 0x84, 0x12, 0xba,
+	0xd3, 0x37, 0x85, 0x92, 0x5f, 0x26, 0x85, 0x52, 0x81, 0x14, 0xfa, 0x75, 0x3c, 0x39, 0x9e, 0x89,
+	0x15, 0x93, 0x6e, 0xbb, 0x64, 0xd5, 0x0d, 0xdc, 0x56, 0x36, 0x44, 0x21, 0xec, 0x44, 0xb8, 0x5b,
+	0xa5, 0x0c, 0xe4, 0x22, 0xef, 0x46, 0xd0, 0x7f, 0xe5, 0x6f, 0x31, 0xf0, 0xa3, 0x2e, 0xf3, 0x1e,
+	0x3d, 0x8d, 0x2f, 0x23, 0xdc, 0xb6, 0x57, 0x2b, 0x86, 0x67, 0x84, 0xdb, 0x26, 0xa7, 0x90, 0x11,
+	0x3f, 0xf4, 0x60, 0x2a, 0xdb, 0xe0, 0x42, 0x28, 0x1e, 0x03, 0xe2, 0xf7, 0xdf, 0x18, 0x98, 0xed,
+	0xf2, 0x7f, 0x76, 0xfd, 0x3b, 0xfd, 0xc0, 0xc5, 0x87, 0x05, 0x6e, 0x62, 0x70, 0xe0, 0x26, 0x4f,
+	0x2f, 0x70, 0x89, 0x2f, 0x13, 0xb8, 0xe4, 0x80, 0x42, 0x9e, 0x1a, 0xa1, 0x90, 0x83, 0xbe, 0x85,
+	0x7c, 0xcb, 0x7f, 0x1f, 0x79, 0x3c, 0x07, 0x84, 0x7f, 0xb6, 0xd3, 0xad, 0x11, 0x7c, 0x1d, 0x7b,
+	0x5d, 0x81, 0x72, 0xbb, 0xd3, 0x89, 0x09, 0xb2, 0x50, 0x71, 0x05, 0x24, 0xe9, 0xd3, 0x5d, 0x3a,
+	0xc2, 0xa2, 0x1b, 0xda, 0x9b, 0xfb, 0xff, 0xbb, 0xfc, 0x2c, 0xf7, 0x01, 0x31, 0x8e, 0x55, 0xcb,
+	0xd6, 0x6a, 0xc8, 0xad, 0xa8, 0x37, 0xeb, 0x2e, 0xed, 0xd2, 0x98, 0xb4, 0xb2, 0x00, 0x2e, 0xf2,
+	0x96, 0xc7, 0xc1, 0xc8, 0xc5, 0xbb, 0xd5, 0xaa, 0xfd, 0xb0, 0x6a, 0x11, 0xaf, 0xd9, 0x55, 0xee,
+	0x8b, 0x66, 0x38, 0xb4, 0x2d, 0x8c, 0xce, 0x83, 0x94, 0x88, 0x04, 0xe6, 0xd5, 0x24, 0x55, 0xec,
+	0x12, 0xe8, 0xee, 0x11, 0xd2, 0x5d, 0xdb, 0xb1, 0x30, 0xc9, 0x8e, 0xf3, 0xdd, 0x0e, 0x41, 0xc9,
+	0x09, 0xdd, 0xec, 0x22, 0x60, 0x63, 0xd7, 0x13, 0xf3, 0x6c, 0xff, 0x5c, 0xb4, 0xce, 0xe1, 0xfd,
+	0x51, 0x8c, 0x2b, 0x86, 0x98, 0x27, 0xee, 0xe9, 0x15, 0x6c, 0x34, 0xab, 0xd8, 0xa0, 0x0e, 0xef,
+	0xf4, 0x6a, 0xc1, 0xbe, 0x4c, 0xfa, 0xdc, 0xbe, 0x4c, 0xf9, 0xb7, 0x24, 0x1c, 0xd8, 0x6b, 0x46,
+	0x60, 0xfc, 0x19, 0x98, 0xd0, 0x29, 0x21, 0xba, 0xd4, 0x06, 0x04, 0x45, 0xeb, 0xc3, 0x65, 0x4e,
+	0xad, 0x4f, 0x2b, 0xbc, 0x3d, 0x07, 0x26, 0x18, 0x4a, 0xf8, 0x17, 0x09, 0x24, 0xc4, 0x08, 0x02,
+	0x97, 0xc3, 0x60, 0xfa, 0xcc, 0x98, 0xf2, 0xca, 0x30, 0x36, 0x6e, 0x50, 0xd9, 0xfc, 0xd3, 0xdb,
+	0xaf, 0xff, 0x3e, 0xbe, 0x0c, 0x2f, 0x6b, 0xa1, 0xd9, 0x58, 0x8c, 0x21, 0xda, 0x63, 0x11, 0x9e,
+	0xa7, 0xf0, 0x5f, 0x12, 0x38, 0x13, 0x98, 0xf4, 0xe0, 0x66, 0x84, 0x99, 0x7e, 0x13, 0xa5, 0xbc,
+	0x35, 0x1a, 0xb3, 0x40, 0x56, 0x60, 0xc8, 0xb6, 0xe0, 0x46, 0x18, 0x99, 0x37, 0x54, 0x86, 0x00,
+	0xfe, 0x47, 0x02, 0x99, 0xde, 0xa1, 0x0d, 0xaa, 0x11, 0x66, 0x23, 0x66, 0x45, 0x59, 0x1b, 0x99,
+	0x5f, 0x20, 0xbd, 0xc6, 0x90, 0x5e, 0x81, 0x85, 0x30, 0xd2, 0x96, 0x27, 0xd3, 0x05, 0xeb, 0x9f,
+	0x43, 0x9f, 0xc2, 0x67, 0x12, 0x48, 0x88, 0xf1, 0x2c, 0x32, 0xb4, 0xc1, 0xc9, 0x2f, 0x32, 0xb4,
+	0x3d, 0x53, 0x9e, 0xb2, 0xc5, 0x60, 0xad, 0xc0, 0xa5, 0x30, 0x2c, 0x31, 0xee, 0x11, 0x9f, 0xeb,
+	0x5e, 0x48, 0x20, 0x21, 0x06, 0xb5, 0x48, 0x20, 0xc1, 0xa9, 0x30, 0x12, 0x48, 0xcf, 0xbc, 0xa7,
+	0xec, 0x30, 0x20, 0x9b, 0x70, 0x3d, 0x0c, 0x84, 0x70, 0xd6, 0x2e, 0x0e, 0xed, 0xf1, 0x31, 0x3e,
+	0x79, 0x0a, 0x1f, 0x81, 0x38, 0x9d, 0xe7, 0xa0, 0x12, 0x99, 0x32, 0x9d, 0x21, 0x51, 0xbe, 0x3c,
+	0x90, 0x47, 0x60, 0x58, 0x67, 0x18, 0x2e, 0xc3, 0x4b, 0xfd, 0xb2, 0xc9, 0x08, 0x78, 0xe2, 0x21,
+	0x98, 0xe4, 0x23, 0x0d, 0x5c, 0x8a, 0xd0, 0x1c, 0x98, 0x9c, 0xe4, 0xe5, 0x21, 0x5c, 0x02, 0xc1,
+	0x22, 0x43, 0x20, 0xc3, 0x6c, 0x18, 0x01, 0x9f, 0x99, 0x60, 0x1b, 0x24, 0xc4, 0xc8, 0x04, 0x17,
+	0xc3, 0x3a, 0x83, 0xd3, 0x94, 0xbc, 0x3a, 0xac, 0xff, 0xf3, 0xec, 0x2a, 0xcc, 0xee, 0x3c, 0x94,
+	0xc3, 0x76, 0xb1, 0x5b, 0x29, 0xd1, 0xa2, 0x05, 0xff, 0x08, 0xd2, 0xbe, 0x61, 0x65, 0x04, 0xeb,
+	0x7d, 0xce, 0xdc, 0x67, 0xda, 0x51, 0x56, 0x98, 0xed, 0x45, 0x98, 0xeb, 0x63, 0x5b, 0xb0, 0x97,
+	0x4c, 0x44, 0xe0, 0x13, 0x90, 0x10, 0xbd, 0x71, 0x64, 0xee, 0x05, 0xa7, 0xa3, 0xc8, 0xdc, 0xeb,
+	0x69, 0xb1, 0x07, 0x9d, 0x9e, 0xf7, 0x57, 0x6e, 0x1b, 0x3e, 0x97, 0x00, 0xe8, 0x76, 0x77, 0x70,
+	0x6d, 0x90, 0x6a, 0x7f, 0x43, 0x2e, 0xaf, 0x8f, 0xc0, 0x29, 0x70, 0x2c, 0x33, 0x1c, 0x79, 0xb8,
+	0x10, 0x85, 0x83, 0x75, 0x1d, 0xf0, 0xcf, 0x12, 0x48, 0x75, 0x1a, 0x0d, 0xb8, 0x3a, 0x48, 0xbf,
+	0x3f, 0x1c, 0x6b, 0xc3, 0x19, 0x05, 0x8e, 0x25, 0x86, 0x23, 0x07, 0xe7, 0xa3, 0x70, 0xb0, 0x7c,
+	0x78, 0x42, 0x8b, 0x12, 0xeb, 0x35, 0x06, 0x14, 0x25, 0x7f, 0x83, 0x33, 0xa0, 0x28, 0x05, 0x1a,
+	0x9e, 0x41, 0xf1, 0xf0, 0x1a, 0x21, 0xf8, 0x4f, 0x09, 0x9c, 0xed, 0xe9, 0x5d, 0xe0, 0x76, 0xd4,
+	0x25, 0xef, 0xdb, 0x02, 0xc9, 0xea, 0xa8, 0xec, 0x02, 0xd6, 0x06, 0x83, 0xb5, 0x04, 0x95, 0x3e,
+	0xe5, 0x81, 0x89, 0x94, 0x50, 0x07, 0xca, 0x2b, 0x09, 0x64, 0x7a, 0xdb, 0x9b, 0xc8, 0x47, 0x26,
+	0xa2, 0x4f, 0x8a, 0x7c, 0x64, 0xa2, 0xfa, 0xa6, 0x41, 0x0f, 0x75, 0x99, 0xcb, 0x94, 0xba, 0x3d,
+	0xdc, 0x3f, 0x24, 0x30, 0x1d, 0xec, 0x6d, 0x60, 0xd4, 0xe3, 0xdb, 0xb7, 0xd3, 0x92, 0xb7, 0x47,
+	0xe4, 0x1e, 0x5e, 0x5d, 0x89, 0x27, 0xc1, 0x32, 0x8b, 0xec, 0x1d, 0xbc, 0xfe, 0x90, 0x93, 0xde,
+	0x7c, 0xc8, 0x49, 0xef, 0x3f, 0xe4, 0xa4, 0x97, 0x1f, 0x73, 0x63, 0x6f, 0x3e, 0xe6, 0xc6, 0xfe,
+	0xf7, 0x31, 0x37, 0x76, 0x7f, 0xd3, 0x37, 0x0b, 0x3c, 0xc2, 0x2e, 0xda, 0x66, 0x8d, 0xbc, 0x4f,
+	0x63, 0x9b, 0xe9, 0x64, 0x43, 0x41, 0x79, 0x92, 0xcd, 0x1f, 0x3f, 0xf9, 0x3e, 0x00, 0x00, 0xff,
+	0xff, 0x1c, 0xaf, 0x0e, 0x94, 0x4b, 0x18, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Account queries an Ethereum account.
+	Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error)
+	// CosmosAccount queries an Ethereum account's Cosmos Address.
+	CosmosAccount(ctx context.Context, in *QueryCosmosAccountRequest, opts ...grpc.CallOption) (*QueryCosmosAccountResponse, error)
+	// ValidatorAccount queries an Ethereum account's from a validator consensus
+	// Address.
+	ValidatorAccount(ctx context.Context, in *QueryValidatorAccountRequest, opts ...grpc.CallOption) (*QueryValidatorAccountResponse, error)
+	// Balance queries the balance of a the EVM denomination for a single
+	// EthAccount.
+	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
+	// Storage queries the balance of all coins for a single account.
+	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
+	// Code queries the balance of all coins for a single account.
+	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
+	// Params queries the parameters of x/evm module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// EthCall implements the `eth_call` rpc api
+	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
+	// EstimateGas implements the `eth_estimateGas` rpc api
+	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// TraceTx implements the `debug_traceTransaction` rpc api
+	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
+	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
+	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error)
+	// BaseFee queries the base fee of the parent block of the current block,
+	// it's similar to feemarket module's method, but also checks london hardfork status.
+	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+	// CreateAllowlist queries the addresses allowed to deploy contracts.
+	CreateAllowlist(ctx context.Context, in *QueryCreateAllowlistRequest, opts ...grpc.CallOption) (*QueryCreateAllowlistResponse, error)
+	// BlockedAddresses queries the addresses blocked in the EVM.
+	BlockedAddresses(ctx context.Context, in *QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*QueryBlockedAddressesResponse, error)
+	// ScheduledCalls queries the contract calls executed at the end of the blocks.
+	ScheduledCalls(ctx context.Context, in *QueryScheduledCallsRequest, opts ...grpc.CallOption) (*QueryScheduledCallsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error) {
+	out := new(QueryAccountResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Account", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CosmosAccount(ctx context.Context, in *QueryCosmosAccountRequest, opts ...grpc.CallOption) (*QueryCosmosAccountResponse, error) {
+	out := new(QueryCosmosAccountResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/CosmosAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorAccount(ctx context.Context, in *QueryValidatorAccountRequest, opts ...grpc.CallOption) (*QueryValidatorAccountResponse, error) {
+	out := new(QueryValidatorAccountResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/ValidatorAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error) {
+	out := new(QueryBalanceResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Balance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
 	return out, nil
 }
 
@@ -1557,6 +2067,15 @@ func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest
 	return out, nil
 }
 
+func (c *queryClient) TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error) {
+	out := new(QueryTraceCallResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
 	out := new(QueryBaseFeeResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/BaseFee", in, out, opts...)
@@ -1566,6 +2085,33 @@ func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) CreateAllowlist(ctx context.Context, in *QueryCreateAllowlistRequest, opts ...grpc.CallOption) (*QueryCreateAllowlistResponse, error) {
+	out := new(QueryCreateAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/CreateAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedAddresses(ctx context.Context, in *QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*QueryBlockedAddressesResponse, error) {
+	out := new(QueryBlockedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/BlockedAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledCalls(ctx context.Context, in *QueryScheduledCallsRequest, opts ...grpc.CallOption) (*QueryScheduledCallsResponse, error) {
+	out := new(QueryScheduledCallsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/ScheduledCalls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account queries an Ethereum account.
@@ -1592,9 +2138,17 @@ type QueryServer interface {
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceCallResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+	// CreateAllowlist queries the addresses allowed to deploy contracts.
+	CreateAllowlist(context.Context, *QueryCreateAllowlistRequest) (*QueryCreateAllowlistResponse, error)
+	// BlockedAddresses queries the addresses blocked in the EVM.
+	BlockedAddresses(context.Context, *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error)
+	// ScheduledCalls queries the contract calls executed at the end of the blocks.
+	ScheduledCalls(context.Context, *QueryScheduledCallsRequest) (*QueryScheduledCallsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1634,9 +2188,21 @@ func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxR
 func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
 }
+func (*UnimplementedQueryServer) TraceCall(ctx context.Context, req *QueryTraceCallRequest) (*QueryTraceCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceCall not implemented")
+}
 func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
+func (*UnimplementedQueryServer) CreateAllowlist(ctx context.Context, req *QueryCreateAllowlistRequest) (*QueryCreateAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAllowlist not implemented")
+}
+func (*UnimplementedQueryServer) BlockedAddresses(ctx context.Context, req *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedAddresses not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledCalls(ctx context.Context, req *QueryScheduledCallsRequest) (*QueryScheduledCallsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledCalls not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1840,20 +2406,92 @@ func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBaseFeeRequest)
+func _Query_TraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceCallRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).BaseFee(ctx, in)
+		return srv.(QueryServer).TraceCall(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethermint.evm.v1.Query/BaseFee",
+		FullMethod: "/ethermint.evm.v1.Query/TraceCall",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+		return srv.(QueryServer).TraceCall(ctx, req.(*QueryTraceCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/BaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CreateAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreateAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreateAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/CreateAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreateAllowlist(ctx, req.(*QueryCreateAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/BlockedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedAddresses(ctx, req.(*QueryBlockedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledCallsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledCalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/ScheduledCalls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledCalls(ctx, req.(*QueryScheduledCallsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1906,10 +2544,26 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TraceBlock",
 			Handler:    _Query_TraceBlock_Handler,
 		},
+		{
+			MethodName: "TraceCall",
+			Handler:    _Query_TraceCall_Handler,
+		},
 		{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
 		},
+		{
+			MethodName: "CreateAllowlist",
+			Handler:    _Query_CreateAllowlist_Handler,
+		},
+		{
+			MethodName: "BlockedAddresses",
+			Handler:    _Query_BlockedAddresses_Handler,
+		},
+		{
+			MethodName: "ScheduledCalls",
+			Handler:    _Query_ScheduledCalls_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/query.proto",
@@ -2481,6 +3135,20 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -2770,6 +3438,129 @@ func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x32
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2828,61 +3619,264 @@ func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryCreateAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryCreateAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreateAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCreateAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreateAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreateAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Balance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Factories) > 0 {
+		for iNdEx := len(m.Factories) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Factories[iNdEx])
+			copy(dAtA[i:], m.Factories[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Factories[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCosmosAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlockedAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCallsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCallsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCallsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCallsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCallsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCallsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryCosmosAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryCosmosAccountResponse) Size() (n int) {
@@ -3094,6 +4088,14 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3209,6 +4211,63 @@ func (m *QueryTraceBlockResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTraceCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraceCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryBaseFeeRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3231,36 +4290,122 @@ func (m *QueryBaseFeeResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryCreateAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *QueryCreateAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+	}
+	if len(m.Factories) > 0 {
+		for _, s := range m.Factories {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBlockedAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockedAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryScheduledCallsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledCallsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
@@ -4888,6 +6033,74 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5696,7 +6909,7 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceCallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5719,67 +6932,70 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceCallRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5789,25 +7005,952 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.BaseFee = &v
-			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceCallResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceCallResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreateAllowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreateAllowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreateAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreateAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreateAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreateAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factories", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Factories = append(m.Factories, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAddressesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAddressesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledCallsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCallsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCallsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledCallsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCallsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCallsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calls = append(m.Calls, ScheduledCall{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
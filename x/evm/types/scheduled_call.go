@@ -0,0 +1,57 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Validate performs a stateless validation of the scheduled call fields.
+func (sc ScheduledCall) Validate() error {
+	if !common.IsHexAddress(sc.Contract) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract address %s", sc.Contract)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sc.Payer); err != nil {
+		return errorsmod.Wrap(err, "invalid payer address")
+	}
+
+	if sc.GasLimit == 0 {
+		return errorsmod.Wrap(ErrInvalidGasLimit, "gas limit must be positive")
+	}
+
+	if sc.Interval == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "interval must be positive")
+	}
+
+	if sc.MaxFailures == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "max failures must be positive")
+	}
+
+	if sc.Allowance.IsNil() || sc.Allowance.IsNegative() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid allowance %s", sc.Allowance)
+	}
+
+	return nil
+}
+
+// Due returns true if the call is enabled and must be executed at the given height.
+func (sc ScheduledCall) Due(height uint64) bool {
+	return !sc.Disabled && height >= sc.NextHeight
+}
@@ -16,10 +16,15 @@
 package types
 
 import (
+	"encoding/json"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+
+	// Force-load the native tracers to trigger registration
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
 )
 
 const (
@@ -35,6 +40,25 @@ type TxTraceResult struct {
 	Error  string      `json:"error,omitempty"`  // Trace failure produced by the tracer
 }
 
+// IsNativeTracer returns true if the tracer type refers to one of the go-ethereum
+// native tracers, e.g. callTracer, prestateTracer or 4byteTracer.
+func IsNativeTracer(tracer string) bool {
+	switch tracer {
+	case "", TracerAccessList, TracerJSON, TracerStruct, TracerMarkdown:
+		return false
+	default:
+		return !tracers.DefaultDirectory.IsJS(tracer)
+	}
+}
+
+// TraceSink defines the destination of the results collected by the node-level
+// native tracer.
+type TraceSink interface {
+	// WriteTrace is called with the tracer result of every transaction executed
+	// in a block.
+	WriteTrace(height int64, txHash common.Hash, result json.RawMessage) error
+}
+
 var _ vm.EVMLogger = &NoOpTracer{}
 
 // NoOpTracer is an empty implementation of vm.Tracer interface
@@ -9,3 +9,13 @@ import (
 func TestNewNoOpTracer(t *testing.T) {
 	require.Equal(t, &NoOpTracer{}, NewNoOpTracer())
 }
+
+func TestIsNativeTracer(t *testing.T) {
+	require.True(t, IsNativeTracer("callTracer"))
+	require.True(t, IsNativeTracer("prestateTracer"))
+	require.True(t, IsNativeTracer("4byteTracer"))
+	require.False(t, IsNativeTracer(""))
+	require.False(t, IsNativeTracer(TracerJSON))
+	require.False(t, IsNativeTracer(TracerStruct))
+	require.False(t, IsNativeTracer("{}"))
+}
@@ -76,7 +76,7 @@ func UnwrapEthereumMsg(tx *sdk.Tx, ethHash common.Hash) (*MsgEthereumTx, error)
 		return nil, fmt.Errorf("invalid tx: nil")
 	}
 
-	for _, msg := range (*tx).GetMsgs() {
+	for _, msg := range UnwrapBundles((*tx).GetMsgs()) {
 		ethMsg, ok := msg.(*MsgEthereumTx)
 		if !ok {
 			return nil, fmt.Errorf("invalid tx type: %T", tx)
@@ -37,6 +37,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
+		GetBurnedBaseFeeCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -72,6 +73,36 @@ If the height is not provided, it will use the latest height from context`,
 	return cmd
 }
 
+// GetBurnedBaseFeeCmd queries the base fee burned in the last block
+func GetBurnedBaseFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned-base-fee",
+		Short: "Get the total base fee burned in the last block",
+		Long: `Get the total base fee burned in the last block, only the last block total is kept.
+The --height flag queries the total burned in the block at the given height from the state at that height`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			ctx := cmd.Context()
+			res, err := queryClient.BurnedBaseFee(ctx, &types.QueryBurnedBaseFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetParamsCmd queries the fee market params
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
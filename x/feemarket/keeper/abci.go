@@ -52,7 +52,7 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	return nil
 }
 
-// EndBlock update block gas wanted.
+// EndBlock update block gas wanted and the base fee burned in the block.
 // The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
@@ -91,5 +91,16 @@ func (k *Keeper) EndBlock(ctx sdk.Context) error {
 		sdk.NewAttribute("height", fmt.Sprintf("%d", ctx.BlockHeight())),
 		sdk.NewAttribute("amount", fmt.Sprintf("%d", gasWanted)),
 	))
+
+	burned := k.GetTransientBurnedBaseFee(ctx)
+	k.SetBlockBurnedBaseFee(ctx, burned)
+
+	if burned.Sign() > 0 {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeBurnBaseFee,
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+			sdk.NewAttribute(types.AttributeKeyAmount, burned.String()),
+		))
+	}
 	return nil
 }
@@ -2,8 +2,11 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 
 	storetypes "cosmossdk.io/store/types"
+
+	"github.com/zeta-chain/ethermint/x/feemarket/types"
 )
 
 func (suite *KeeperTestSuite) TestEndBlock() {
@@ -45,3 +48,26 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestEndBlockBurnedBaseFee() {
+	suite.SetupTest()
+	suite.ctx = suite.ctx.WithBlockGasMeter(storetypes.NewGasMeter(uint64(1000000000)))
+
+	suite.app.FeeMarketKeeper.AddTransientBurnedBaseFee(suite.ctx, big.NewInt(100))
+	suite.app.FeeMarketKeeper.AddTransientBurnedBaseFee(suite.ctx, big.NewInt(50))
+
+	suite.Require().NoError(suite.app.FeeMarketKeeper.EndBlock(suite.ctx))
+	suite.Require().Equal(big.NewInt(150), suite.app.FeeMarketKeeper.GetBlockBurnedBaseFee(suite.ctx))
+
+	var found bool
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeBurnBaseFee {
+			continue
+		}
+		found = true
+		amount, ok := event.GetAttribute(types.AttributeKeyAmount)
+		suite.Require().True(ok)
+		suite.Require().Equal("150", amount.Value)
+	}
+	suite.Require().True(found)
+}
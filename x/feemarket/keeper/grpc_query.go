@@ -61,3 +61,12 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: int64(gas),
 	}, nil
 }
+
+// BurnedBaseFee implements the Query/BurnedBaseFee gRPC method
+func (k Keeper) BurnedBaseFee(c context.Context, _ *types.QueryBurnedBaseFeeRequest) (*types.QueryBurnedBaseFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBurnedBaseFeeResponse{
+		BurnedBaseFee: sdkmath.NewIntFromBigInt(k.GetBlockBurnedBaseFee(ctx)),
+	}, nil
+}
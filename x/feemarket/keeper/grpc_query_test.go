@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"math/big"
+
 	sdkmath "cosmossdk.io/math"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/zeta-chain/ethermint/x/feemarket/types"
@@ -99,3 +101,12 @@ func (suite *KeeperTestSuite) TestQueryBlockGas() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryBurnedBaseFee() {
+	suite.SetupTest()
+	suite.app.FeeMarketKeeper.SetBlockBurnedBaseFee(suite.ctx, big.NewInt(1000))
+
+	res, err := suite.queryClient.BurnedBaseFee(suite.ctx.Context(), &types.QueryBurnedBaseFeeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(1000), res.BurnedBaseFee)
+}
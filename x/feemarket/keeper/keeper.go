@@ -117,6 +117,38 @@ func (k Keeper) AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64
 	return result, nil
 }
 
+// ----------------------------------------------------------------------------
+// Burned Base Fee
+// ----------------------------------------------------------------------------
+
+// SetBlockBurnedBaseFee sets the base fee burned in the last block to the store.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) SetBlockBurnedBaseFee(ctx sdk.Context, amount *big.Int) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixBlockBurnedBaseFee, amount.Bytes())
+}
+
+// GetBlockBurnedBaseFee returns the base fee burned in the last block from the store.
+func (k Keeper) GetBlockBurnedBaseFee(ctx sdk.Context) *big.Int {
+	store := ctx.KVStore(k.storeKey)
+	return new(big.Int).SetBytes(store.Get(types.KeyPrefixBlockBurnedBaseFee))
+}
+
+// GetTransientBurnedBaseFee returns the base fee burned in the current block from transient store.
+func (k Keeper) GetTransientBurnedBaseFee(ctx sdk.Context) *big.Int {
+	store := ctx.TransientStore(k.transientKey)
+	return new(big.Int).SetBytes(store.Get(types.KeyPrefixTransientBurnedBaseFee))
+}
+
+// AddTransientBurnedBaseFee adds the base fee burned by a transaction to the cumulative
+// amount of the current block in the transient store.
+func (k Keeper) AddTransientBurnedBaseFee(ctx sdk.Context, amount *big.Int) *big.Int {
+	result := new(big.Int).Add(k.GetTransientBurnedBaseFee(ctx), amount)
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientBurnedBaseFee, result.Bytes())
+	return result
+}
+
 // GetBaseFeeV1 get the base fee from v1 version of states.
 // return nil if base fee is not enabled
 // TODO: Figure out if this will be deleted ?
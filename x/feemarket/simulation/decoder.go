@@ -0,0 +1,54 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/zeta-chain/ethermint/x/feemarket/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// value to the corresponding fee market type.
+func NewDecodeStore() func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, types.ParamsKey):
+			var paramsA, paramsB types.Params
+			types.ModuleCdc.MustUnmarshal(kvA.Value, &paramsA)
+			types.ModuleCdc.MustUnmarshal(kvB.Value, &paramsB)
+
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+		case bytes.Equal(kvA.Key, types.KeyPrefixBlockGasWanted):
+			gasA := sdk.BigEndianToUint64(kvA.Value)
+			gasB := sdk.BigEndianToUint64(kvB.Value)
+
+			return fmt.Sprintf("%v\n%v", gasA, gasB)
+		case bytes.Equal(kvA.Key, types.KeyPrefixBlockBurnedBaseFee):
+			burnedA := new(big.Int).SetBytes(kvA.Value)
+			burnedB := new(big.Int).SetBytes(kvB.Value)
+
+			return fmt.Sprintf("%v\n%v", burnedA, burnedB)
+		default:
+			panic(fmt.Sprintf("invalid fee market key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package simulation_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/zeta-chain/ethermint/x/feemarket/simulation"
+	"github.com/zeta-chain/ethermint/x/feemarket/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	dec := simulation.NewDecodeStore()
+
+	params := types.DefaultParams()
+	paramsBz := types.ModuleCdc.MustMarshal(&params)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ParamsKey, Value: paramsBz},
+			{Key: types.KeyPrefixBlockGasWanted, Value: sdk.Uint64ToBigEndian(21000)},
+			{Key: types.KeyPrefixBlockBurnedBaseFee, Value: big.NewInt(1000).Bytes()},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"BlockGasWanted", "21000\n21000"},
+		{"BlockBurnedBaseFee", "1000\n1000"},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) })
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]))
+			}
+		})
+	}
+}
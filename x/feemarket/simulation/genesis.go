@@ -0,0 +1,123 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/zeta-chain/ethermint/blob/main/LICENSE
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/zeta-chain/ethermint/x/feemarket/types"
+)
+
+// Simulation parameter constants
+const (
+	NoBaseFee                = "no_base_fee"
+	BaseFeeChangeDenominator = "base_fee_change_denominator"
+	ElasticityMultiplier     = "elasticity_multiplier"
+	BaseFee                  = "base_fee"
+	EnableHeight             = "enable_height"
+	MinGasMultiplier         = "min_gas_multiplier"
+	BurnBaseFee              = "burn_base_fee"
+	BlockGas                 = "block_gas"
+)
+
+// GenNoBaseFee randomized NoBaseFee, the base fee is enabled with a 80% probability.
+func GenNoBaseFee(r *rand.Rand) bool {
+	return r.Int63n(101) > 80
+}
+
+// GenBaseFeeChangeDenominator randomized BaseFeeChangeDenominator
+func GenBaseFeeChangeDenominator(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 1, 17))
+}
+
+// GenElasticityMultiplier randomized ElasticityMultiplier
+func GenElasticityMultiplier(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 1, 9))
+}
+
+// GenBaseFee randomized BaseFee, up to 1 gwei.
+func GenBaseFee(r *rand.Rand) sdkmath.Int {
+	return sdkmath.NewInt(r.Int63n(1_000_000_001))
+}
+
+// GenEnableHeight randomized EnableHeight
+func GenEnableHeight(r *rand.Rand) int64 {
+	return r.Int63n(100)
+}
+
+// GenMinGasMultiplier randomized MinGasMultiplier
+func GenMinGasMultiplier(r *rand.Rand) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecWithPrec(r.Int63n(101), 2)
+}
+
+// GenBurnBaseFee randomized BurnBaseFee
+func GenBurnBaseFee(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenBlockGas randomized BlockGas
+func GenBlockGas(r *rand.Rand) uint64 {
+	return uint64(r.Int63n(10_000_000))
+}
+
+// RandomizedGenState generates a random GenesisState for the fee market module. The
+// minimum gas price is left disabled as it applies to every transaction, including
+// the ones generated by the operations of the cosmos modules.
+func RandomizedGenState(simState *module.SimulationState) {
+	var noBaseFee bool
+	simState.AppParams.GetOrGenerate(NoBaseFee, &noBaseFee, simState.Rand, func(r *rand.Rand) { noBaseFee = GenNoBaseFee(r) })
+
+	var baseFeeChangeDenom uint32
+	simState.AppParams.GetOrGenerate(BaseFeeChangeDenominator, &baseFeeChangeDenom, simState.Rand, func(r *rand.Rand) { baseFeeChangeDenom = GenBaseFeeChangeDenominator(r) })
+
+	var elasticityMultiplier uint32
+	simState.AppParams.GetOrGenerate(ElasticityMultiplier, &elasticityMultiplier, simState.Rand, func(r *rand.Rand) { elasticityMultiplier = GenElasticityMultiplier(r) })
+
+	var baseFee sdkmath.Int
+	simState.AppParams.GetOrGenerate(BaseFee, &baseFee, simState.Rand, func(r *rand.Rand) { baseFee = GenBaseFee(r) })
+
+	var enableHeight int64
+	simState.AppParams.GetOrGenerate(EnableHeight, &enableHeight, simState.Rand, func(r *rand.Rand) { enableHeight = GenEnableHeight(r) })
+
+	var minGasMultiplier sdkmath.LegacyDec
+	simState.AppParams.GetOrGenerate(MinGasMultiplier, &minGasMultiplier, simState.Rand, func(r *rand.Rand) { minGasMultiplier = GenMinGasMultiplier(r) })
+
+	var burnBaseFee bool
+	simState.AppParams.GetOrGenerate(BurnBaseFee, &burnBaseFee, simState.Rand, func(r *rand.Rand) { burnBaseFee = GenBurnBaseFee(r) })
+
+	var blockGas uint64
+	simState.AppParams.GetOrGenerate(BlockGas, &blockGas, simState.Rand, func(r *rand.Rand) { blockGas = GenBlockGas(r) })
+
+	params := types.NewParams(
+		noBaseFee, baseFeeChangeDenom, elasticityMultiplier, 0, enableHeight, sdkmath.LegacyZeroDec(), minGasMultiplier,
+	)
+	params.BaseFee = baseFee
+	params.BurnBaseFee = burnBaseFee
+	feemarketGenesis := types.NewGenesisState(params, blockGas)
+
+	bz, err := json.MarshalIndent(feemarketGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated fee market parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(feemarketGenesis)
+}
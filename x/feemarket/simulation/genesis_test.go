@@ -0,0 +1,42 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/zeta-chain/ethermint/x/feemarket/simulation"
+	"github.com/zeta-chain/ethermint/x/feemarket/types"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	for seed := int64(0); seed < 10; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+			Rand:         r,
+			NumBonded:    3,
+			BondDenom:    sdk.DefaultBondDenom,
+			Accounts:     simtypes.RandomAccounts(r, 3),
+			InitialStake: sdkmath.NewInt(1000),
+			GenState:     make(map[string]json.RawMessage),
+		}
+		simulation.RandomizedGenState(&simState)
+
+		var genesis types.GenesisState
+		simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genesis)
+
+		require.NoError(t, genesis.Validate())
+		require.True(t, genesis.Params.MinGasPrice.IsZero())
+	}
+}
@@ -17,7 +17,10 @@ package types
 
 // feemarket module events
 const (
-	EventTypeFeeMarket = "fee_market"
+	EventTypeFeeMarket   = "fee_market"
+	EventTypeBurnBaseFee = "burn_base_fee"
 
 	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyHeight  = "height"
+	AttributeKeyAmount  = "amount"
 )
@@ -43,6 +43,9 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// burn_base_fee burns the base fee part of the EVM transaction fees, only the
+	// priority tip is sent to the fee collector.
+	BurnBaseFee bool `protobuf:"varint,9,opt,name=burn_base_fee,json=burnBaseFee,proto3" json:"burn_base_fee,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -106,6 +109,13 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetBurnBaseFee() bool {
+	if m != nil {
+		return m.BurnBaseFee
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
 }
@@ -115,33 +125,34 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 405 bytes of a gzipped FileDescriptorProto
+	// 419 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6a, 0xdb, 0x40,
-	0x10, 0xc6, 0xbd, 0x75, 0xe2, 0x38, 0x9b, 0x1a, 0xcc, 0x92, 0x14, 0xd1, 0x50, 0xc5, 0x34, 0x50,
-	0x7c, 0xa9, 0x84, 0xc9, 0xa5, 0x97, 0x5e, 0xdc, 0xd0, 0xf4, 0x2f, 0xa4, 0x3a, 0xf6, 0xb2, 0x8c,
-	0x36, 0x13, 0x69, 0x88, 0x76, 0x57, 0x68, 0x37, 0xa1, 0xee, 0x53, 0xf4, 0x4d, 0xfa, 0x1a, 0x39,
-	0xe6, 0x58, 0x7a, 0x08, 0xc5, 0x7e, 0x91, 0x12, 0x29, 0x91, 0x0c, 0xbd, 0xf4, 0x26, 0xcd, 0xf7,
-	0xfd, 0x3e, 0x66, 0xd8, 0x8f, 0xbf, 0x40, 0x9f, 0x63, 0xa5, 0xc9, 0xf8, 0xf8, 0x1c, 0x51, 0x43,
-	0x75, 0x81, 0x3e, 0xbe, 0x9a, 0x75, 0x3f, 0x51, 0x59, 0x59, 0x6f, 0xc5, 0x93, 0xd6, 0x17, 0x75,
-	0xd2, 0xd5, 0xec, 0xe9, 0x6e, 0x66, 0x33, 0x5b, 0x5b, 0xe2, 0xbb, 0xaf, 0xc6, 0xfd, 0xfc, 0x67,
-	0x9f, 0x0f, 0x4e, 0xa1, 0x02, 0xed, 0x44, 0xc8, 0x77, 0x8c, 0x95, 0x29, 0x38, 0x94, 0xe7, 0x88,
-	0x01, 0x9b, 0xb0, 0xe9, 0x30, 0xd9, 0x36, 0x76, 0x0e, 0x0e, 0xdf, 0x22, 0x8a, 0xd7, 0x7c, 0xff,
-	0x41, 0x94, 0x2a, 0x07, 0x93, 0xa1, 0x3c, 0x43, 0x63, 0x35, 0x19, 0xf0, 0xb6, 0x0a, 0x1e, 0x4d,
-	0xd8, 0x74, 0x94, 0x04, 0x69, 0xe3, 0x7e, 0x53, 0x1b, 0x8e, 0x3b, 0x5d, 0x1c, 0xf1, 0x3d, 0x2c,
-	0xc0, 0x79, 0x52, 0xe4, 0x17, 0x52, 0x5f, 0x16, 0x9e, 0xca, 0x82, 0xb0, 0x0a, 0xfa, 0x35, 0xb8,
-	0xdb, 0x89, 0x9f, 0x5b, 0x4d, 0x1c, 0xf2, 0x11, 0x1a, 0x48, 0x0b, 0x94, 0x39, 0x52, 0x96, 0xfb,
-	0x60, 0x73, 0xc2, 0xa6, 0xfd, 0xe4, 0x71, 0x33, 0x7c, 0x57, 0xcf, 0xc4, 0x2b, 0x3e, 0x6c, 0xb7,
-	0x1e, 0x4c, 0xd8, 0x74, 0x7b, 0xfe, 0xec, 0xfa, 0xf6, 0xa0, 0xf7, 0xfb, 0xf6, 0x60, 0x4f, 0x59,
-	0xa7, 0xad, 0x73, 0x67, 0x17, 0x11, 0xd9, 0x58, 0x83, 0xcf, 0xa3, 0xf7, 0xc6, 0x27, 0x5b, 0xf7,
-	0x4b, 0x8a, 0x13, 0x3e, 0xd2, 0x64, 0x64, 0x06, 0x4e, 0x96, 0x15, 0x29, 0x0c, 0xb6, 0x6a, 0xfc,
-	0xf0, 0x1e, 0xdf, 0xff, 0x17, 0xff, 0x84, 0x19, 0xa8, 0xc5, 0x31, 0xaa, 0x64, 0x47, 0x93, 0x39,
-	0x01, 0x77, 0x7a, 0xc7, 0x89, 0x2f, 0x5c, 0x3c, 0x04, 0xad, 0x5d, 0x36, 0xfc, 0xff, 0xb4, 0x71,
-	0x93, 0xd6, 0x9d, 0xfe, 0x61, 0x63, 0xb8, 0x31, 0xde, 0x4c, 0xc6, 0x64, 0xc8, 0x13, 0x14, 0xed,
-	0xbb, 0xcc, 0x3f, 0x5e, 0x2f, 0x43, 0x76, 0xb3, 0x0c, 0xd9, 0x9f, 0x65, 0xc8, 0x7e, 0xac, 0xc2,
-	0xde, 0xcd, 0x2a, 0xec, 0xfd, 0x5a, 0x85, 0xbd, 0xaf, 0xb3, 0x8c, 0x7c, 0x7e, 0x99, 0x46, 0xca,
-	0xea, 0xf8, 0x3b, 0x7a, 0x78, 0xa9, 0x72, 0x20, 0x13, 0x77, 0xbd, 0xf9, 0xb6, 0xd6, 0x1c, 0xbf,
-	0x28, 0xd1, 0xa5, 0x83, 0xba, 0x05, 0x47, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x26, 0x24, 0xc1,
-	0x6a, 0x5d, 0x02, 0x00, 0x00,
+	0x10, 0xc6, 0xad, 0x3a, 0x71, 0xec, 0x75, 0x0d, 0x66, 0x49, 0x8a, 0x68, 0xa8, 0x62, 0x12, 0x28,
+	0xbe, 0x54, 0xc2, 0xe4, 0xd2, 0x4b, 0x2f, 0x6e, 0x68, 0xfa, 0x17, 0x52, 0x1d, 0x7b, 0x59, 0x46,
+	0x9b, 0x89, 0x34, 0x44, 0xbb, 0x6b, 0x76, 0xd7, 0xa1, 0xee, 0x53, 0xf4, 0xb1, 0x72, 0x0c, 0xf4,
+	0x52, 0x7a, 0x08, 0xc5, 0x7e, 0x91, 0x62, 0xd9, 0xb1, 0x0c, 0xbd, 0xe4, 0xb6, 0x3b, 0xdf, 0xef,
+	0x1b, 0x66, 0x98, 0x8f, 0xbd, 0x44, 0x5f, 0xa0, 0x55, 0xa4, 0x7d, 0x72, 0x85, 0xa8, 0xc0, 0x5e,
+	0xa3, 0x4f, 0x6e, 0x46, 0xf5, 0x27, 0x9e, 0x58, 0xe3, 0x0d, 0x7f, 0xb6, 0xe1, 0xe2, 0x5a, 0xba,
+	0x19, 0x3d, 0xdf, 0xcf, 0x4d, 0x6e, 0x2a, 0x24, 0x59, 0xbe, 0x56, 0xf4, 0xf1, 0xaf, 0x26, 0x6b,
+	0x5d, 0x80, 0x05, 0xe5, 0x78, 0xc4, 0xba, 0xda, 0x88, 0x0c, 0x1c, 0x8a, 0x2b, 0xc4, 0x30, 0x18,
+	0x04, 0xc3, 0x76, 0xda, 0xd1, 0x66, 0x0c, 0x0e, 0xdf, 0x21, 0xf2, 0x37, 0xec, 0xf0, 0x41, 0x14,
+	0xb2, 0x00, 0x9d, 0xa3, 0xb8, 0x44, 0x6d, 0x14, 0x69, 0xf0, 0xc6, 0x86, 0x4f, 0x06, 0xc1, 0xb0,
+	0x97, 0x86, 0xd9, 0x8a, 0x7e, 0x5b, 0x01, 0x67, 0xb5, 0xce, 0x4f, 0xd9, 0x01, 0x96, 0xe0, 0x3c,
+	0x49, 0xf2, 0x33, 0xa1, 0xa6, 0xa5, 0xa7, 0x49, 0x49, 0x68, 0xc3, 0x66, 0x65, 0xdc, 0xaf, 0xc5,
+	0x2f, 0x1b, 0x8d, 0x9f, 0xb0, 0x1e, 0x6a, 0xc8, 0x4a, 0x14, 0x05, 0x52, 0x5e, 0xf8, 0x70, 0x77,
+	0x10, 0x0c, 0x9b, 0xe9, 0xd3, 0x55, 0xf1, 0x7d, 0x55, 0xe3, 0xaf, 0x59, 0x7b, 0x33, 0x75, 0x6b,
+	0x10, 0x0c, 0x3b, 0xe3, 0x17, 0xb7, 0xf7, 0x47, 0x8d, 0x3f, 0xf7, 0x47, 0x07, 0xd2, 0x38, 0x65,
+	0x9c, 0xbb, 0xbc, 0x8e, 0xc9, 0x24, 0x0a, 0x7c, 0x11, 0x7f, 0xd0, 0x3e, 0xdd, 0x5b, 0x0f, 0xc9,
+	0xcf, 0x59, 0x4f, 0x91, 0x16, 0x39, 0x38, 0x31, 0xb1, 0x24, 0x31, 0xdc, 0xab, 0xec, 0x27, 0x6b,
+	0xfb, 0xe1, 0xff, 0xf6, 0xcf, 0x98, 0x83, 0x9c, 0x9d, 0xa1, 0x4c, 0xbb, 0x8a, 0xf4, 0x39, 0xb8,
+	0x8b, 0xa5, 0x8f, 0x7f, 0x65, 0xfc, 0xa1, 0xd1, 0xd6, 0x66, 0xed, 0xc7, 0x77, 0xeb, 0xaf, 0xba,
+	0x6d, 0xad, 0x7e, 0xcc, 0x7a, 0xd9, 0xd4, 0xea, 0xfa, 0x20, 0x9d, 0xea, 0x20, 0xdd, 0x65, 0x71,
+	0x7d, 0x92, 0x8f, 0x3b, 0xed, 0x9d, 0xfe, 0x6e, 0xda, 0x27, 0x4d, 0x9e, 0xa0, 0xdc, 0xa0, 0xe3,
+	0x4f, 0xb7, 0xf3, 0x28, 0xb8, 0x9b, 0x47, 0xc1, 0xdf, 0x79, 0x14, 0xfc, 0x5c, 0x44, 0x8d, 0xbb,
+	0x45, 0xd4, 0xf8, 0xbd, 0x88, 0x1a, 0xdf, 0x46, 0x39, 0xf9, 0x62, 0x9a, 0xc5, 0xd2, 0xa8, 0xe4,
+	0x07, 0x7a, 0x78, 0x25, 0x0b, 0x20, 0x9d, 0xd4, 0xd9, 0xfa, 0xbe, 0x95, 0x2e, 0x3f, 0x9b, 0xa0,
+	0xcb, 0x5a, 0x55, 0x52, 0x4e, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x83, 0x06, 0x73, 0xed, 0x81,
+	0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -164,6 +175,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BurnBaseFee {
+		i--
+		if m.BurnBaseFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -257,6 +278,9 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BurnBaseFee {
+		n += 2
+	}
 	return n
 }
 
@@ -474,6 +498,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnBaseFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnBaseFee = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
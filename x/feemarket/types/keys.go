@@ -35,18 +35,22 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBlockBurnedBaseFee
 )
 
 const (
 	prefixTransientBlockGasUsed = iota + 1
+	prefixTransientBurnedBaseFee
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockGasWanted     = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockBurnedBaseFee = []byte{prefixBlockBurnedBaseFee}
 )
 
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
+	KeyPrefixTransientBurnedBaseFee  = []byte{prefixTransientBurnedBaseFee}
 )
@@ -32,6 +32,8 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBurnBaseFee is false (i.e the base fee goes to the fee collector)
+	DefaultBurnBaseFee = false
 )
 
 // Parameter keys
@@ -95,6 +97,7 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		BurnBaseFee:              DefaultBurnBaseFee,
 	}
 }
 
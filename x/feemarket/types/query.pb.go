@@ -274,6 +274,84 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryBurnedBaseFeeRequest defines the request type for querying the base fee
+// burned in the last block.
+type QueryBurnedBaseFeeRequest struct {
+}
+
+func (m *QueryBurnedBaseFeeRequest) Reset()         { *m = QueryBurnedBaseFeeRequest{} }
+func (m *QueryBurnedBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBurnedBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBurnedBaseFeeResponse returns the total base fee burned in the last
+// block, only the last block total is kept in the store.
+type QueryBurnedBaseFeeResponse struct {
+	// burned_base_fee is the total base fee burned in the last block
+	BurnedBaseFee cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=burned_base_fee,json=burnedBaseFee,proto3,customtype=cosmossdk.io/math.Int" json:"burned_base_fee"`
+}
+
+func (m *QueryBurnedBaseFeeResponse) Reset()         { *m = QueryBurnedBaseFeeResponse{} }
+func (m *QueryBurnedBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBurnedBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedBaseFeeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
@@ -281,6 +359,8 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryBurnedBaseFeeRequest)(nil), "ethermint.feemarket.v1.QueryBurnedBaseFeeRequest")
+	proto.RegisterType((*QueryBurnedBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBurnedBaseFeeResponse")
 }
 
 func init() {
@@ -288,35 +368,39 @@ func init() {
 }
 
 var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xf7, 0x11, 0x48, 0xcb, 0xb1, 0xa0, 0x23, 0xa9, 0xc0, 0x42, 0xd7, 0x60, 0xa4, 0xaa, 0x05,
-	0x7a, 0xa7, 0x14, 0x46, 0x26, 0x0f, 0x20, 0x04, 0x03, 0x98, 0x8d, 0xa5, 0x3a, 0x9b, 0x57, 0xdb,
-	0x4a, 0xed, 0x73, 0x7d, 0x97, 0x8a, 0x32, 0xb2, 0xb1, 0x20, 0x04, 0x9f, 0x86, 0x6f, 0x90, 0x31,
-	0x12, 0x0b, 0x62, 0x88, 0x50, 0xc2, 0x07, 0x41, 0xf6, 0xd9, 0x21, 0x06, 0x4c, 0xb3, 0x9d, 0x9e,
-	0x7f, 0xff, 0xde, 0xef, 0xc9, 0xd8, 0x01, 0x1d, 0x41, 0x9e, 0xc4, 0xa9, 0xe6, 0x47, 0x00, 0x89,
-	0xc8, 0x47, 0xa0, 0xf9, 0xe9, 0x90, 0x9f, 0x8c, 0x21, 0x3f, 0x63, 0x59, 0x2e, 0xb5, 0x24, 0x5b,
-	0x4b, 0x0c, 0x5b, 0x62, 0xd8, 0xe9, 0xd0, 0xee, 0x85, 0x32, 0x94, 0x25, 0x84, 0x17, 0x2f, 0x83,
-	0xb6, 0x77, 0x5a, 0x14, 0x7f, 0x53, 0x0d, 0xee, 0x66, 0x28, 0x65, 0x78, 0x0c, 0x5c, 0x64, 0x31,
-	0x17, 0x69, 0x2a, 0xb5, 0xd0, 0xb1, 0x4c, 0x95, 0xf9, 0xea, 0xf4, 0x30, 0x79, 0x51, 0x44, 0x78,
-	0x2e, 0x72, 0x91, 0x28, 0x0f, 0x4e, 0xc6, 0xa0, 0xb4, 0xf3, 0x12, 0x5f, 0x6b, 0x4c, 0x55, 0x26,
-	0x53, 0x05, 0xe4, 0x21, 0xee, 0x66, 0xe5, 0xe4, 0x3a, 0x1a, 0xa0, 0xdd, 0x2b, 0x07, 0x94, 0xfd,
-	0x3b, 0x31, 0x33, 0x3c, 0xf7, 0xe2, 0x64, 0xb6, 0x6d, 0x79, 0x15, 0xc7, 0xe9, 0x57, 0xa2, 0xae,
-	0x50, 0xf0, 0x08, 0xa0, 0xf6, 0x7a, 0x86, 0x7b, 0xcd, 0x71, 0x65, 0xf6, 0x00, 0x6f, 0xfa, 0x42,
-	0xc1, 0xe1, 0x11, 0x40, 0x69, 0x77, 0xd9, 0xbd, 0xf1, 0x7d, 0xb6, 0xdd, 0x0f, 0xa4, 0x4a, 0xa4,
-	0x52, 0xaf, 0x47, 0x2c, 0x96, 0x3c, 0x11, 0x3a, 0x62, 0x4f, 0x52, 0xed, 0x6d, 0xf8, 0x86, 0xed,
-	0x6c, 0xd5, 0x6a, 0xc7, 0x32, 0x18, 0x3d, 0x16, 0xcb, 0x8d, 0xf6, 0x70, 0xff, 0x8f, 0x79, 0x65,
-	0x73, 0x15, 0x77, 0x42, 0x61, 0x16, 0xea, 0x78, 0xc5, 0xf3, 0xe0, 0x4b, 0x07, 0x5f, 0x2a, 0xb1,
-	0xe4, 0x3d, 0xc2, 0x5d, 0xb3, 0x0a, 0xb9, 0xd3, 0xb6, 0xea, 0xdf, 0xed, 0xd9, 0x77, 0xd7, 0xc2,
-	0x1a, 0x7f, 0x67, 0xe7, 0xdd, 0xd7, 0x9f, 0x9f, 0x2f, 0x0c, 0x08, 0xe5, 0x2d, 0xf7, 0x34, 0xed,
-	0x91, 0x0f, 0x08, 0x6f, 0x54, 0x15, 0x91, 0xff, 0x1b, 0x34, 0xfb, 0xb5, 0xef, 0xad, 0x07, 0xae,
-	0xe2, 0xec, 0x96, 0x71, 0x1c, 0x32, 0x68, 0x8b, 0x53, 0xdf, 0x84, 0x7c, 0x42, 0x78, 0xb3, 0x6e,
-	0x93, 0x9c, 0x63, 0xd2, 0x3c, 0x86, 0xbd, 0xbf, 0x26, 0xba, 0xca, 0xb4, 0x57, 0x66, 0xba, 0x4d,
-	0x6e, 0xb5, 0x66, 0x2a, 0x18, 0x87, 0xa1, 0x50, 0xee, 0xd3, 0xc9, 0x9c, 0xa2, 0xe9, 0x9c, 0xa2,
-	0x1f, 0x73, 0x8a, 0x3e, 0x2e, 0xa8, 0x35, 0x5d, 0x50, 0xeb, 0xdb, 0x82, 0x5a, 0xaf, 0x86, 0x61,
-	0xac, 0xa3, 0xb1, 0xcf, 0x02, 0x99, 0xf0, 0xb7, 0xa0, 0xc5, 0x7e, 0x10, 0x89, 0x38, 0x5d, 0x51,
-	0x7c, 0xb3, 0xa2, 0xa9, 0xcf, 0x32, 0x50, 0x7e, 0xb7, 0xfc, 0x45, 0xee, 0xff, 0x0a, 0x00, 0x00,
-	0xff, 0xff, 0x51, 0xd1, 0x7e, 0xe2, 0xbc, 0x03, 0x00, 0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x6b, 0x36, 0xba, 0x61, 0x34, 0x81, 0x4c, 0x3b, 0xb1, 0x00, 0x59, 0x09, 0xd2, 0x58,
+	0x81, 0xc5, 0x6a, 0xe1, 0xc8, 0xa9, 0x12, 0x20, 0x04, 0x07, 0x28, 0x37, 0x2e, 0x95, 0x93, 0xbd,
+	0xa5, 0x51, 0x97, 0x38, 0x8b, 0xdd, 0x89, 0x71, 0xe4, 0xc6, 0x05, 0x21, 0xf8, 0x10, 0x7c, 0x95,
+	0x1d, 0x27, 0x71, 0x41, 0x1c, 0x26, 0xd4, 0xf2, 0x41, 0x50, 0x6c, 0xa7, 0x34, 0xa5, 0x19, 0xdd,
+	0x2d, 0x7a, 0xfe, 0xbf, 0xff, 0xff, 0xe7, 0xe7, 0xa7, 0x60, 0x07, 0x64, 0x1f, 0xd2, 0x28, 0x8c,
+	0x25, 0xdd, 0x03, 0x88, 0x58, 0x3a, 0x00, 0x49, 0x0f, 0x5b, 0xf4, 0x60, 0x08, 0xe9, 0x91, 0x9b,
+	0xa4, 0x5c, 0x72, 0xb2, 0x3e, 0xd1, 0xb8, 0x13, 0x8d, 0x7b, 0xd8, 0xb2, 0x6a, 0x01, 0x0f, 0xb8,
+	0x92, 0xd0, 0xec, 0x4b, 0xab, 0xad, 0xad, 0x12, 0xc7, 0xbf, 0xad, 0x5a, 0x77, 0x33, 0xe0, 0x3c,
+	0xd8, 0x07, 0xca, 0x92, 0x90, 0xb2, 0x38, 0xe6, 0x92, 0xc9, 0x90, 0xc7, 0x42, 0x9f, 0x3a, 0x35,
+	0x4c, 0x5e, 0x67, 0x08, 0xaf, 0x58, 0xca, 0x22, 0xd1, 0x85, 0x83, 0x21, 0x08, 0xe9, 0xbc, 0xc1,
+	0xd7, 0x0a, 0x55, 0x91, 0xf0, 0x58, 0x00, 0x79, 0x8c, 0xab, 0x89, 0xaa, 0x5c, 0x47, 0x0d, 0xb4,
+	0x7d, 0xb9, 0x6d, 0xbb, 0xf3, 0x89, 0x5d, 0xdd, 0xd7, 0x59, 0x3e, 0x3e, 0xdd, 0xac, 0x74, 0x4d,
+	0x8f, 0x53, 0x37, 0xa6, 0x1d, 0x26, 0xe0, 0x29, 0x40, 0x9e, 0xf5, 0x12, 0xd7, 0x8a, 0x65, 0x13,
+	0xf6, 0x08, 0xaf, 0x7a, 0x4c, 0x40, 0x6f, 0x0f, 0x40, 0xc5, 0x5d, 0xea, 0x6c, 0xfc, 0x3c, 0xdd,
+	0xac, 0xfb, 0x5c, 0x44, 0x5c, 0x88, 0xdd, 0x81, 0x1b, 0x72, 0x1a, 0x31, 0xd9, 0x77, 0x9f, 0xc7,
+	0xb2, 0xbb, 0xe2, 0xe9, 0x6e, 0x67, 0x3d, 0x77, 0xdb, 0xe7, 0xfe, 0xe0, 0x19, 0x9b, 0xdc, 0xa8,
+	0x89, 0xeb, 0x33, 0x75, 0x13, 0x73, 0x15, 0x2f, 0x05, 0x4c, 0x5f, 0x68, 0xa9, 0x9b, 0x7d, 0x3a,
+	0x37, 0xf0, 0x86, 0x96, 0x0e, 0xd3, 0x18, 0x76, 0x67, 0x68, 0x7d, 0x6c, 0xcd, 0x3b, 0x34, 0x66,
+	0x4f, 0xf0, 0x15, 0x4f, 0x1d, 0xf4, 0x66, 0xd0, 0x6f, 0x65, 0x93, 0x28, 0xc7, 0x5f, 0xf3, 0xa6,
+	0xed, 0xda, 0xe3, 0x65, 0x7c, 0x51, 0xa5, 0x90, 0x8f, 0x08, 0x57, 0xf5, 0x30, 0xc9, 0xbd, 0xb2,
+	0x61, 0xff, 0xfb, 0x7e, 0xd6, 0xfd, 0x85, 0xb4, 0x1a, 0xda, 0xd9, 0xfa, 0xf0, 0xfd, 0xf7, 0xd7,
+	0x0b, 0x0d, 0x62, 0xd3, 0x92, 0x8d, 0xd2, 0xef, 0x47, 0x3e, 0x21, 0xbc, 0x62, 0x08, 0xc9, 0xd9,
+	0x01, 0xc5, 0x99, 0x59, 0x0f, 0x16, 0x13, 0x1b, 0x9c, 0x6d, 0x85, 0xe3, 0x90, 0x46, 0x19, 0x4e,
+	0x3e, 0x5a, 0xf2, 0x05, 0xe1, 0xd5, 0xfc, 0x3d, 0xc9, 0x7f, 0x42, 0x8a, 0xeb, 0x60, 0xed, 0x2c,
+	0xa8, 0x36, 0x4c, 0x4d, 0xc5, 0x74, 0x87, 0xdc, 0x2e, 0x65, 0xca, 0x3a, 0x7a, 0x01, 0x13, 0xe4,
+	0x1b, 0xc2, 0x6b, 0x85, 0xe5, 0x20, 0xad, 0xb3, 0xb3, 0xe6, 0x6c, 0x99, 0xd5, 0x3e, 0x4f, 0x8b,
+	0x61, 0xa4, 0x8a, 0xb1, 0x49, 0xee, 0x96, 0x32, 0x16, 0x37, 0xb3, 0xf3, 0xe2, 0x78, 0x64, 0xa3,
+	0x93, 0x91, 0x8d, 0x7e, 0x8d, 0x6c, 0xf4, 0x79, 0x6c, 0x57, 0x4e, 0xc6, 0x76, 0xe5, 0xc7, 0xd8,
+	0xae, 0xbc, 0x6d, 0x05, 0xa1, 0xec, 0x0f, 0x3d, 0xd7, 0xe7, 0x11, 0x7d, 0x0f, 0x92, 0xed, 0xf8,
+	0x7d, 0x16, 0xc6, 0x53, 0xbe, 0xef, 0xa6, 0x9c, 0xe5, 0x51, 0x02, 0xc2, 0xab, 0xaa, 0xdf, 0xc9,
+	0xc3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x69, 0x62, 0x98, 0x0f, 0xe8, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -337,6 +421,8 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BurnedBaseFee queries the total base fee burned in the last block
+	BurnedBaseFee(ctx context.Context, in *QueryBurnedBaseFeeRequest, opts ...grpc.CallOption) (*QueryBurnedBaseFeeResponse, error)
 }
 
 type queryClient struct {
@@ -374,6 +460,15 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BurnedBaseFee(ctx context.Context, in *QueryBurnedBaseFeeRequest, opts ...grpc.CallOption) (*QueryBurnedBaseFeeResponse, error) {
+	out := new(QueryBurnedBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BurnedBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -382,6 +477,8 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BurnedBaseFee queries the total base fee burned in the last block
+	BurnedBaseFee(context.Context, *QueryBurnedBaseFeeRequest) (*QueryBurnedBaseFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -397,6 +494,9 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) BurnedBaseFee(ctx context.Context, req *QueryBurnedBaseFeeRequest) (*QueryBurnedBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedBaseFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -456,6 +556,24 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurnedBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/BurnedBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedBaseFee(ctx, req.(*QueryBurnedBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -472,6 +590,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BurnedBaseFee",
+			Handler:    _Query_BurnedBaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -642,6 +764,62 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBurnedBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BurnedBaseFee.Size()
+		i -= size
+		if _, err := m.BurnedBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -716,6 +894,26 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBurnedBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnedBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BurnedBaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1110,6 +1308,140 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBurnedBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0